@@ -14,17 +14,24 @@ import (
 	"real-time-leaderboard/internal/config"
 	v1Auth "real-time-leaderboard/internal/module/auth/adapters/rest/v1"
 	authApp "real-time-leaderboard/internal/module/auth/application"
+	authEmail "real-time-leaderboard/internal/module/auth/infrastructure/email"
 	authJWT "real-time-leaderboard/internal/module/auth/infrastructure/jwt"
 	authInfra "real-time-leaderboard/internal/module/auth/infrastructure/repository"
 	v1Leaderboard "real-time-leaderboard/internal/module/leaderboard/adapters/rest/v1"
 	leaderboardApp "real-time-leaderboard/internal/module/leaderboard/application"
+	leaderboardDomain "real-time-leaderboard/internal/module/leaderboard/domain"
 	leaderboardBroadcastInfra "real-time-leaderboard/internal/module/leaderboard/infrastructure/broadcast"
 	leaderboardInfra "real-time-leaderboard/internal/module/leaderboard/infrastructure/repository"
+	leaderboardWebhookInfra "real-time-leaderboard/internal/module/leaderboard/infrastructure/webhook"
 	"real-time-leaderboard/internal/shared/database"
+	"real-time-leaderboard/internal/shared/lock"
 	"real-time-leaderboard/internal/shared/logger"
 	"real-time-leaderboard/internal/shared/middleware"
 	redisInfra "real-time-leaderboard/internal/shared/redis"
 	"real-time-leaderboard/internal/shared/response"
+	"real-time-leaderboard/internal/shared/retry"
+	"real-time-leaderboard/internal/shared/supervisor"
+	"real-time-leaderboard/internal/shared/version"
 	"real-time-leaderboard/spa"
 
 	"github.com/gin-gonic/gin"
@@ -36,12 +43,32 @@ func main() {
 	if err != nil {
 		panic(fmt.Sprintf("Failed to load config: %v", err))
 	}
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Sprintf("Invalid config: %v", err))
+	}
 
 	// Initialize logger
-	l := logger.New(cfg.Logger.Level, cfg.Logger.Pretty)
+	logOutput, err := logger.OpenOutput(cfg.Logger.Output, logger.RotationOptions{
+		MaxSizeMB:  cfg.Logger.FileMaxSizeMB,
+		MaxBackups: cfg.Logger.FileMaxBackups,
+		MaxAgeDays: cfg.Logger.FileMaxAgeDays,
+		Compress:   cfg.Logger.FileCompress,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to open log output: %v", err))
+	}
+	l := logger.NewWithOptions(logger.Options{
+		Level:      cfg.Logger.Level,
+		Format:     cfg.Logger.GetFormat(),
+		SampleRate: cfg.Logger.SampleRate,
+		Output:     logOutput,
+	})
 
 	// Initialize database
-	db, err := database.NewPostgres(cfg.Database, l)
+	startupRetry := retry.Policy{MaxAttempts: cfg.Startup.MaxAttempts, BaseDelay: cfg.Startup.BaseDelay}
+	db, err := retry.Connect(context.TODO(), startupRetry, "database", l, func() (*database.Postgres, error) {
+		return database.NewPostgres(cfg.Database, l)
+	})
 	if err != nil {
 		l.Errorf(context.TODO(), "Failed to connect to database: %v", err)
 		return
@@ -49,7 +76,9 @@ func main() {
 	defer db.Close()
 
 	// Initialize Redis
-	redisClient, err := redisInfra.NewClient(cfg.Redis, l)
+	redisClient, err := retry.Connect(context.TODO(), startupRetry, "Redis", l, func() (*redisInfra.Client, error) {
+		return redisInfra.NewClient(cfg.Redis, l)
+	})
 	if err != nil {
 		l.Errorf(context.TODO(), "Failed to connect to Redis: %v", err)
 		return
@@ -62,26 +91,113 @@ func main() {
 
 	// Initialize repositories
 	userRepo := authInfra.NewPostgresUserRepository(db.Pool)
-	jwtMgr := authJWT.NewManager(cfg.JWT.SecretKey, cfg.JWT.AccessExpiry, cfg.JWT.RefreshExpiry)
+	verificationKeys := make([]authJWT.Key, 0, len(cfg.JWT.VerificationKeys))
+	for id, secret := range cfg.JWT.VerificationKeys {
+		verificationKeys = append(verificationKeys, authJWT.Key{ID: id, Secret: secret})
+	}
+	signingKey := authJWT.Key{ID: cfg.JWT.KeyID, Secret: cfg.JWT.SecretKey}
+	jwtMgr := authJWT.NewManager(signingKey, verificationKeys, cfg.JWT.AccessExpiry, cfg.JWT.RefreshExpiry, cfg.JWT.Leeway)
 
-	persistenceRepo := leaderboardInfra.NewPostgresLeaderboardRepository(db.Pool)
-	cacheRepo := leaderboardInfra.NewRedisLeaderboardRepository(redisClient.GetClient())
+	// Config.Validate only accepts "desc" and "asc"
+	sortOrder := leaderboardDomain.SortOrder(cfg.Leaderboard.Order)
+	persistenceRepo := leaderboardInfra.NewPostgresLeaderboardRepository(db.Pool, sortOrder, cfg.Leaderboard.MinBoardScore)
+	cacheRepo := leaderboardInfra.NewRedisLeaderboardRepository(redisClient.GetClient(), sortOrder, cfg.Leaderboard.MinBoardScore, cfg.Leaderboard.BoardTTL)
 	leaderboardUserRepo := leaderboardInfra.NewUserRepository(db.Pool)
+	if cfg.Leaderboard.UsernameCacheTTL > 0 {
+		leaderboardUserRepo = leaderboardInfra.NewCachedUserRepository(leaderboardUserRepo, redisClient.GetClient(), cfg.Leaderboard.UsernameCacheTTL)
+	}
+	scoreAuditRepo := leaderboardInfra.NewPostgresScoreAuditRepository(db.Pool)
+	seasonRepo := leaderboardInfra.NewPostgresSeasonRepository(db.Pool, sortOrder)
+	viewerPresenceRepo := leaderboardInfra.NewRedisViewerPresenceRepository(redisClient.GetClient())
+	var quotaRepo leaderboardApp.SubmissionQuotaRepository
+	if cfg.Leaderboard.DailySubmissionQuota > 0 {
+		quotaRepo = leaderboardInfra.NewRedisSubmissionQuotaRepository(redisClient.GetClient())
+	}
 
 	// Initialize broadcast service (infrastructure layer)
 	broadcastService := leaderboardBroadcastInfra.NewRedisBroadcastService(redisClient.GetClient(), l)
 
 	// Initialize use cases
-	authUseCase := authApp.NewAuthUseCase(userRepo, jwtMgr, l)
-	scoreUseCase := leaderboardApp.NewScoreUseCase(persistenceRepo, cacheRepo, leaderboardUserRepo, broadcastService, l)
-	leaderboardUseCase := leaderboardApp.NewLeaderboardUseCase(cacheRepo, persistenceRepo, leaderboardUserRepo, broadcastService, l)
+	var verificationSender authApp.VerificationSender
+	if cfg.Leaderboard.RequireVerifiedEmail {
+		verificationSender = authEmail.NewLogVerificationSender(l)
+	}
+	authConfig := authApp.AuthConfig{
+		QueryTimeout:           cfg.Database.QueryTimeout,
+		IdempotentRegistration: cfg.Auth.IdempotentRegistration,
+		AllowedEmailDomains:    cfg.Auth.AllowedEmailDomains,
+		DeniedEmailDomains:     cfg.Auth.DeniedEmailDomains,
+		MinLoginDuration:       cfg.Auth.MinLoginDuration,
+	}
+	authUseCase := authApp.NewAuthUseCase(userRepo, jwtMgr, verificationSender, authConfig, l)
+	usernameConfig := leaderboardApp.UsernameConfig{
+		Fallback: cfg.Leaderboard.UsernameFallback,
+		Required: cfg.Leaderboard.UsernameRequired,
+	}
+	scoreConfig := leaderboardApp.ScoreConfig{
+		TrackActivity:        cfg.Leaderboard.InactiveWindow > 0,
+		RequireVerifiedEmail: cfg.Leaderboard.RequireVerifiedEmail,
+		QueryTimeout:         cfg.Database.QueryTimeout,
+		MaxScore:             cfg.Leaderboard.MaxScore,
+		MinScore:             cfg.Leaderboard.MinScore,
+		Usernames:            usernameConfig,
+		DisableBroadcast:     !cfg.Leaderboard.BroadcastEnabled,
+		AllowZeroScore:       cfg.Leaderboard.AllowZeroScore,
+		DailySubmissionQuota: int64(cfg.Leaderboard.DailySubmissionQuota),
+		AllowScoreReset:      cfg.Leaderboard.AllowScoreReset,
+		ShadowMaxScore:       cfg.Leaderboard.ShadowMaxScore,
+	}
+	var leaderNotifier leaderboardApp.LeaderNotifier
+	if cfg.Leaderboard.LeaderWebhookURL != "" {
+		leaderNotifier = leaderboardWebhookInfra.NewLeaderWebhookNotifier(cfg.Leaderboard.LeaderWebhookURL, cfg.Leaderboard.WebhookMaxAttempts, cfg.Leaderboard.WebhookBaseDelay, l)
+	}
+	scoreUseCase := leaderboardApp.NewScoreUseCase(persistenceRepo, cacheRepo, leaderboardUserRepo, broadcastService, leaderNotifier, scoreAuditRepo, quotaRepo, scoreConfig, l)
+	leaderboardUseCase := leaderboardApp.NewLeaderboardUseCase(cacheRepo, persistenceRepo, leaderboardUserRepo, broadcastService, viewerPresenceRepo, cfg.Database.QueryTimeout, usernameConfig, l)
+	auditUseCase := leaderboardApp.NewAuditUseCase(scoreAuditRepo, cfg.Database.QueryTimeout, l)
+	seasonUseCase := leaderboardApp.NewSeasonUseCase(seasonRepo, cacheRepo, cfg.Database.QueryTimeout, l)
+
+	// Background workers are stopped, and waited for, when the server shuts down
+	workers := supervisor.New(l)
+
+	if cfg.Leaderboard.InactiveWindow > 0 {
+		evictor := leaderboardApp.NewInactivityEvictor(cacheRepo, cfg.Leaderboard.InactiveWindow, cfg.Leaderboard.EvictionInterval, l)
+		// Only the instance holding the job lease sweeps, so replicas do not repeat the work
+		jobLock := lock.New(redisClient.GetClient(), leaderboardDomain.RedisJobLeaderKey, leaderboardDomain.JobLeaderLeaseTTL)
+		workers.Add("inactivity-eviction", func(ctx context.Context) error {
+			lock.RunAsLeader(ctx, jobLock, evictor.Run, l)
+			return nil
+		})
+		l.Infof(context.TODO(), "Inactive player eviction enabled (window=%s)", cfg.Leaderboard.InactiveWindow)
+	}
 
 	// Initialize handlers
 	authHandler := v1Auth.NewHandler(authUseCase, l)
-	leaderboardHandler := v1Leaderboard.NewLeaderboardHandler(leaderboardUseCase, scoreUseCase, l)
+	leaderboardHandler := v1Leaderboard.NewLeaderboardHandler(leaderboardUseCase, scoreUseCase, cfg.Leaderboard.MaxStreamDuration, cfg.Leaderboard.PollTimeout, cfg.Leaderboard.MaxIdleKeepAlives, cfg.Leaderboard.FreshnessHeaderEnabled, l)
+	auditHandler := v1Leaderboard.NewAuditHandler(auditUseCase, l)
+	seasonHandler := v1Leaderboard.NewSeasonHandler(seasonUseCase, l)
+
+	// Score submissions must be signed, and each signature used once, when a signing secret is set
+	var submitMiddleware []gin.HandlerFunc
+	if cfg.Leaderboard.SubmissionSigningSecret != "" {
+		nonceRepo := leaderboardInfra.NewRedisSubmissionNonceRepository(redisClient.GetClient())
+		signatureMiddleware := middleware.NewSignatureMiddleware(cfg.Leaderboard.SubmissionSigningSecret, cfg.Leaderboard.SubmissionSignatureMaxAge, nonceRepo.Reserve, l)
+		submitMiddleware = append(submitMiddleware, signatureMiddleware.RequireSignature())
+	}
+
+	// Registrations are counted per client IP and hour when a limit is set
+	var registerMiddleware []gin.HandlerFunc
+	if cfg.Auth.RegistrationsPerIPPerHour > 0 {
+		throttleRepo := authInfra.NewRedisRegistrationThrottleRepository(redisClient.GetClient())
+		throttleMiddleware := middleware.NewIPThrottleMiddleware(int64(cfg.Auth.RegistrationsPerIPPerHour), time.Hour, throttleRepo.Increment, l)
+		registerMiddleware = append(registerMiddleware, throttleMiddleware.Throttle())
+	}
 
 	// Setup router
-	router := setupRouter(cfg, l, authUseCase, authHandler, leaderboardHandler)
+	router, err := setupRouter(cfg, l, authUseCase, authHandler, leaderboardHandler, auditHandler, seasonHandler, registerMiddleware, submitMiddleware, db.SlowQueries)
+	if err != nil {
+		l.Errorf(context.TODO(), "Failed to set up router: %v", err)
+		return
+	}
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -94,17 +210,31 @@ func main() {
 
 	// Start server in a goroutine
 	go func() {
-		l.Infof(context.TODO(), "Server starting on %s", cfg.Server.GetAddr())
+		buildInfo := version.Get()
+		l.Infof(context.TODO(), "Server %s (commit %s) starting on %s", buildInfo.Version, buildInfo.Commit, cfg.Server.GetAddr())
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			l.Errorf(context.TODO(), "Failed to start server: %v", err)
 			os.Exit(1)
 		}
 	}()
 
+	// Start background workers; a failing worker stops the others and shuts the server down
+	bgCtx, bgCancel := context.WithCancel(context.Background())
+	defer bgCancel()
+	var workersErr error
+	workersDone := make(chan struct{})
+	go func() {
+		workersErr = workers.Run(bgCtx)
+		close(workersDone)
+	}()
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	select {
+	case <-quit:
+	case <-workersDone:
+	}
 
 	l.Info(context.TODO(), "Shutting down server...")
 
@@ -116,6 +246,13 @@ func main() {
 		l.Errorf(context.TODO(), "Server forced to shutdown: %v", err)
 	}
 
+	// Wait for every worker to exit before the deferred Redis and PostgreSQL closes run
+	bgCancel()
+	<-workersDone
+	if workersErr != nil {
+		l.Errorf(context.TODO(), "Background worker failed: %v", workersErr)
+	}
+
 	l.Info(context.TODO(), "Server exited")
 }
 
@@ -125,7 +262,12 @@ func setupRouter(
 	authUseCase authApp.AuthUseCase,
 	authHandler *v1Auth.Handler,
 	leaderboardHandler *v1Leaderboard.LeaderboardHandler,
-) *gin.Engine {
+	auditHandler *v1Leaderboard.AuditHandler,
+	seasonHandler *v1Leaderboard.SeasonHandler,
+	registerMiddleware []gin.HandlerFunc,
+	submitMiddleware []gin.HandlerFunc,
+	slowQueries func() int64,
+) (*gin.Engine, error) {
 	// Set gin mode based on config
 	if cfg.Logger.Level == "debug" {
 		gin.SetMode(gin.DebugMode)
@@ -136,13 +278,25 @@ func setupRouter(
 	// Create router with correct settings
 	router := gin.New()
 
+	// Only honor X-Forwarded-For from known proxies so c.ClientIP() cannot be spoofed
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+
+	// Log every request, including health checks and docs, unless its path starts with a LOG_SKIP_PATHS prefix.
+	// Mounted first so it also sees routes outside /api; it logs after the rest of the chain completes.
+	router.Use(middleware.RequestLogger(l, cfg.Logger.SkipPaths...))
+
 	// Health check
 	router.GET("/health", func(c *gin.Context) {
 		response.Success(c, gin.H{"status": "ok"}, "Service is healthy")
 	})
 
+	// Build info (values injected via -ldflags)
+	router.GET("/version", version.Handler)
+
 	// Setup API router (with middleware, grouped by /api)
-	setupAPIRouter(router, l, authUseCase, authHandler, leaderboardHandler)
+	setupAPIRouter(router, cfg, l, authUseCase, authHandler, leaderboardHandler, auditHandler, seasonHandler, registerMiddleware, submitMiddleware, slowQueries)
 
 	// Setup docs router (without middleware, prefixed by /docs)
 	setupDocsRouter(router)
@@ -150,15 +304,21 @@ func setupRouter(
 	// Setup SPA router - handles all SPA routes and catch-all for client-side routing
 	setupSPARouter(router)
 
-	return router
+	return router, nil
 }
 
 func setupAPIRouter(
 	router *gin.Engine,
+	cfg *config.Config,
 	l *logger.Logger,
 	authUseCase authApp.AuthUseCase,
 	authHandler *v1Auth.Handler,
 	leaderboardHandler *v1Leaderboard.LeaderboardHandler,
+	auditHandler *v1Leaderboard.AuditHandler,
+	seasonHandler *v1Leaderboard.SeasonHandler,
+	registerMiddleware []gin.HandlerFunc,
+	submitMiddleware []gin.HandlerFunc,
+	slowQueries func() int64,
 ) {
 	// Group API routes by /api prefix
 	apiGroup := router.Group("/api")
@@ -167,11 +327,23 @@ func setupAPIRouter(
 	// 1. Recovery - First to catch panics from all other middleware
 	// 2. RequestID - Early to generate ID for all subsequent middleware and logs
 	// 3. CORS - After RequestID so responses include request ID, but early for OPTIONS handling
-	// 4. RequestLogger - Last to log after request processing completes
+	// RequestLogger is mounted on the root router in setupRouter
 	apiGroup.Use(middleware.Recovery(l))
 	apiGroup.Use(middleware.RequestID())
 	apiGroup.Use(middleware.CORS())
-	apiGroup.Use(middleware.RequestLogger(l))
+
+	// Keep one request's fanned-out queries from exhausting the connection pool
+	apiGroup.Use(middleware.QueryLimit(cfg.Database.MaxQueriesPerRequest))
+
+	// Opt-in so clients can detect envelope changes across releases
+	if cfg.Server.ExposeAPIVersion {
+		apiGroup.Use(middleware.APIVersion(version.Get().Version))
+	}
+
+	// Compress large JSON payloads; SSE streams pass through unbuffered
+	if cfg.Server.GzipEnabled {
+		apiGroup.Use(middleware.Gzip(cfg.Server.GzipMinSize))
+	}
 
 	// API v1 routes
 	v1Group := apiGroup.Group("/v1")
@@ -189,16 +361,23 @@ func setupAPIRouter(
 		})
 
 		// Auth routes (no auth required)
-		authHandler.RegisterPublicRoutes(v1PublicGroup)
+		authHandler.RegisterPublicRoutes(v1PublicGroup, registerMiddleware...)
 
-		// Public leaderboard routes (no auth required)
-		leaderboardHandler.RegisterPublicRoutes(v1PublicGroup)
+		// Season history (no auth required)
+		seasonHandler.RegisterPublicRoutes(v1PublicGroup)
+	}
+
+	authMiddleware := middleware.NewAuthMiddleware(authUseCase.ValidateToken, authUseCase.IsAdmin, l)
+
+	// Optional auth routes group (public, caller identified when a valid token is sent)
+	v1OptionalAuthGroup := v1Group.Group("")
+	v1OptionalAuthGroup.Use(authMiddleware.OptionalAuth())
+	{
+		// Public leaderboard routes (include_self needs the caller's identity)
+		leaderboardHandler.RegisterPublicRoutes(v1OptionalAuthGroup)
 	}
 
 	// Protected routes group (auth required)
-	authMiddleware := middleware.NewAuthMiddleware(func(ctx context.Context, token string) (string, error) {
-		return authUseCase.ValidateToken(ctx, token)
-	}, l)
 	v1ProtectedGroup := v1Group.Group("")
 	v1ProtectedGroup.Use(authMiddleware.RequireAuth())
 	{
@@ -206,7 +385,22 @@ func setupAPIRouter(
 		authHandler.RegisterProtectedRoutes(v1ProtectedGroup)
 
 		// Protected leaderboard routes (auth required)
-		leaderboardHandler.RegisterProtectedRoutes(v1ProtectedGroup)
+		leaderboardHandler.RegisterProtectedRoutes(v1ProtectedGroup, submitMiddleware...)
+	}
+
+	// Admin routes group (auth and admin role required)
+	v1AdminGroup := v1Group.Group("/admin")
+	v1AdminGroup.Use(authMiddleware.RequireAuth(), authMiddleware.RequireAdmin())
+	{
+		auditHandler.RegisterAdminRoutes(v1AdminGroup)
+		authHandler.RegisterAdminRoutes(v1AdminGroup)
+		leaderboardHandler.RegisterAdminRoutes(v1AdminGroup)
+		seasonHandler.RegisterAdminRoutes(v1AdminGroup)
+
+		// Queries this instance reported at or above DB_SLOW_QUERY_THRESHOLD since startup
+		v1AdminGroup.GET("/debug/slow-queries", func(c *gin.Context) {
+			response.Success(c, gin.H{"slow_queries": slowQueries()}, "Slow queries retrieved successfully")
+		})
 	}
 }
 
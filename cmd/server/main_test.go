@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"real-time-leaderboard/api"
+	"real-time-leaderboard/internal/config"
+	authmocks "real-time-leaderboard/internal/module/auth/adapters/mocks"
+	v1Auth "real-time-leaderboard/internal/module/auth/adapters/rest/v1"
+	v1Leaderboard "real-time-leaderboard/internal/module/leaderboard/adapters/rest/v1"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// ginParam matches gin path parameters such as :user_id
+var ginParam = regexp.MustCompile(`:([^/]+)`)
+
+func TestSetupAPIRouter_EveryV1RouteShouldBeDocumentedInOpenAPISpec(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	doc, err := openapi3.NewLoader().LoadFromData(api.OpenAPIV1YAML)
+	require.NoError(t, err)
+	require.NoError(t, doc.Validate(context.Background()))
+
+	l := logger.New("info", false)
+	router := gin.New()
+	setupAPIRouter(
+		router,
+		&config.Config{},
+		l,
+		authmocks.NewMockAuthUseCase(ctrl),
+		v1Auth.NewHandler(nil, l),
+		v1Leaderboard.NewLeaderboardHandler(nil, nil, 0, 0, 0, false, l),
+		v1Leaderboard.NewAuditHandler(nil, l),
+		v1Leaderboard.NewSeasonHandler(nil, l),
+		nil,
+		nil,
+		func() int64 { return 0 },
+	)
+
+	// Serving the spec is not part of the API it describes
+	undocumented := map[string]bool{"/openapi.yaml": true, "/openapi.json": true}
+
+	// ── Act / Assert ────────────────────────────────────────────────────
+	checked := 0
+	for _, route := range router.Routes() {
+		path, ok := strings.CutPrefix(route.Path, "/api/v1")
+		if !ok || undocumented[path] {
+			continue
+		}
+		checked++
+
+		specPath := ginParam.ReplaceAllString(path, "{$1}")
+		item := doc.Paths.Value(specPath)
+		require.NotNil(t, item, "route %s %s has no path in api/v1/openapi.yaml", route.Method, route.Path)
+		require.NotNil(t, item.GetOperation(route.Method), "route %s %s has no operation in api/v1/openapi.yaml", route.Method, route.Path)
+	}
+	require.NotZero(t, checked)
+}
+
+func TestSetupAPIRouter_DebugBroadcast_WhenUnauthenticated_ShouldReturn401(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	l := logger.New("info", false)
+	router := gin.New()
+	setupAPIRouter(
+		router,
+		&config.Config{},
+		l,
+		authmocks.NewMockAuthUseCase(ctrl),
+		v1Auth.NewHandler(nil, l),
+		v1Leaderboard.NewLeaderboardHandler(nil, nil, 0, 0, 0, false, l),
+		v1Leaderboard.NewAuditHandler(nil, l),
+		v1Leaderboard.NewSeasonHandler(nil, l),
+		nil,
+		nil,
+		func() int64 { return 0 },
+	)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/debug/broadcast", nil)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// serveThroughRouter sends a GET for path through the full router built by setupRouter with the default config
+// and returns what was logged
+func serveThroughRouter(t *testing.T, path string) string {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	l := logger.NewWithOptions(logger.Options{Level: "info", Format: logger.FormatJSON, Output: &buf})
+
+	router, err := setupRouter(
+		cfg,
+		l,
+		authmocks.NewMockAuthUseCase(ctrl),
+		v1Auth.NewHandler(nil, l),
+		v1Leaderboard.NewLeaderboardHandler(nil, nil, 0, 0, 0, false, l),
+		v1Leaderboard.NewAuditHandler(nil, l),
+		v1Leaderboard.NewSeasonHandler(nil, l),
+		nil,
+		nil,
+		func() int64 { return 0 },
+	)
+	require.NoError(t, err)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+
+	return buf.String()
+}
+
+func TestSetupRouter_WhenHealthChecked_ShouldNotLogRequest(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	out := serveThroughRouter(t, "/health")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NotContains(t, out, "/health")
+}
+
+func TestSetupRouter_WhenAPIRequested_ShouldLogRequest(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	out := serveThroughRouter(t, "/api/v1/openapi.json")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Contains(t, out, "/api/v1/openapi.json")
+}
+
+func TestSetupAPIRouter_DebugSlowQueries_WhenAdmin_ShouldReturnCount(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().ValidateToken(gomock.Any(), "admin-token").Return("admin-1", nil).Times(1)
+	mockAuth.EXPECT().IsAdmin(gomock.Any(), "admin-1").Return(true, nil).Times(1)
+
+	l := logger.New("info", false)
+	router := gin.New()
+	setupAPIRouter(
+		router,
+		&config.Config{},
+		l,
+		mockAuth,
+		v1Auth.NewHandler(nil, l),
+		v1Leaderboard.NewLeaderboardHandler(nil, nil, 0, 0, 0, false, l),
+		v1Leaderboard.NewAuditHandler(nil, l),
+		v1Leaderboard.NewSeasonHandler(nil, l),
+		nil,
+		nil,
+		func() int64 { return 7 },
+	)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/debug/slow-queries", nil)
+	req.Header.Set("Authorization", "Bearer admin-token")
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data struct {
+			SlowQueries int64 `json:"slow_queries"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, int64(7), body.Data.SlowQueries)
+}
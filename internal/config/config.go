@@ -2,19 +2,24 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	JWT      JWTConfig
-	Logger   LoggerConfig
+	Server      ServerConfig
+	Database    DatabaseConfig
+	Redis       RedisConfig
+	JWT         JWTConfig
+	Auth        AuthConfig
+	Logger      LoggerConfig
+	Leaderboard LeaderboardConfig
+	Startup     StartupConfig
 }
 
 // ServerConfig holds server configuration
@@ -24,6 +29,14 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	IdleTimeout  time.Duration
+	// TrustedProxies lists proxy IPs/CIDRs whose X-Forwarded-For is honored for the client IP (empty trusts none)
+	TrustedProxies []string
+	// ExposeAPIVersion adds the X-API-Version header and api_version envelope field to API responses
+	ExposeAPIVersion bool
+	// GzipEnabled compresses API responses for clients that accept gzip
+	GzipEnabled bool
+	// GzipMinSize is the smallest response body, in bytes, that gets compressed
+	GzipMinSize int
 }
 
 // DatabaseConfig holds database configuration
@@ -37,6 +50,12 @@ type DatabaseConfig struct {
 	MaxConnections  int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+	// QueryTimeout bounds each use-case operation's repository calls (0 disables)
+	QueryTimeout time.Duration
+	// MaxQueriesPerRequest bounds how many queries one API request runs at once (0 disables)
+	MaxQueriesPerRequest int
+	// SlowQueryThreshold is the duration at which a query is logged and counted as slow (0 disables)
+	SlowQueryThreshold time.Duration
 }
 
 // RedisConfig holds Redis configuration
@@ -51,41 +70,143 @@ type RedisConfig struct {
 
 // JWTConfig holds JWT configuration
 type JWTConfig struct {
-	SecretKey     string
-	AccessExpiry  time.Duration
-	RefreshExpiry time.Duration
+	SecretKey string
+	// KeyID is written to the kid header of new tokens so they can be matched to SecretKey after a rotation
+	KeyID string
+	// VerificationKeys maps the kid of retired signing keys to their secrets; tokens they signed keep validating.
+	// The empty kid matches tokens issued without a kid header.
+	VerificationKeys map[string]string
+	AccessExpiry     time.Duration
+	RefreshExpiry    time.Duration
+	// Leeway tolerates clock skew between services when checking token exp/nbf/iat
+	Leeway time.Duration
+}
+
+// AuthConfig holds authentication behavior configuration
+type AuthConfig struct {
+	// IdempotentRegistration returns the existing user and fresh tokens when a registration is retried
+	// with the same username, email and password, instead of a conflict
+	IdempotentRegistration bool
+	// AllowedEmailDomains and DeniedEmailDomains filter registrations by email domain;
+	// "*.example.com" matches any subdomain of example.com
+	AllowedEmailDomains []string
+	DeniedEmailDomains  []string
+	// MinLoginDuration pads every login to take at least this long, hiding timing differences (0 disables)
+	MinLoginDuration time.Duration
+	// RegistrationsPerIPPerHour caps registrations from one client IP in each clock hour (0 disables)
+	RegistrationsPerIPPerHour int
 }
 
 // LoggerConfig holds logger configuration
 type LoggerConfig struct {
 	Level  string
 	Pretty bool
+	// Format is "json" or "console"; when empty it follows Pretty
+	Format string
+	// SampleRate keeps 1 of every N debug/info logs (0 disables)
+	SampleRate uint32
+	// Output is "stderr", "stdout", or a file path rotated by the File* settings
+	Output         string
+	FileMaxSizeMB  int
+	FileMaxBackups int
+	FileMaxAgeDays int
+	FileCompress   bool
+	// SkipPaths lists request path prefixes that are not request-logged (e.g. health checks)
+	SkipPaths []string
+}
+
+// LeaderboardConfig holds leaderboard behavior configuration
+type LeaderboardConfig struct {
+	// InactiveWindow evicts players from the cached board after this long without a submission (0 disables).
+	// PostgreSQL keeps their scores, so reads served from it still include them.
+	InactiveWindow   time.Duration
+	EvictionInterval time.Duration
+	// LeaderWebhookURL receives a POST whenever a user takes rank 1 (empty disables)
+	LeaderWebhookURL   string
+	WebhookMaxAttempts int
+	WebhookBaseDelay   time.Duration
+	// RequireVerifiedEmail issues verification tokens on registration and blocks score submission until verified
+	RequireVerifiedEmail bool
+	// MaxStreamDuration closes SSE streams after this long so clients reconnect (0 disables)
+	MaxStreamDuration time.Duration
+	// MaxIdleKeepAlives closes SSE streams after this many keep-alives in a row without an update (0 disables)
+	MaxIdleKeepAlives int
+	// PollTimeout is how long GET /leaderboard/poll waits for a change before answering 304 (0 answers at once)
+	PollTimeout time.Duration
+	// Order is "desc" (highest score ranks first) or "asc" (lowest score ranks first, e.g. golf or speedruns)
+	Order string
+	// MaxScore rejects score submissions above it (0 uses the float64-safe limit 2^53)
+	MaxScore int64
+	// MinScore rejects score increments that would take a total below it
+	MinScore int64
+	// AllowZeroScore accepts score submissions of 0 instead of rejecting them as missing
+	AllowZeroScore bool
+	// MinBoardScore keeps best scores below it stored but off the leaderboard (0 disables)
+	MinBoardScore int64
+	// SubmissionSigningSecret requires score submissions to be HMAC-signed with this secret (empty disables)
+	SubmissionSigningSecret string
+	// SubmissionSignatureMaxAge rejects signed submissions whose timestamp is further than this from the server clock
+	SubmissionSignatureMaxAge time.Duration
+	// BoardTTL expires the cached board in Redis after this long without a write (0 never expires)
+	BoardTTL time.Duration
+	// DailySubmissionQuota caps each user's score submissions and increments per UTC day (0 disables)
+	DailySubmissionQuota int
+	// UsernameCacheTTL caches usernames used to enrich entries in Redis for this long (0 disables)
+	UsernameCacheTTL time.Duration
+	// UsernameFallback is shown when a username cannot be loaded; "{id}" expands to the first 8 characters of the user ID
+	UsernameFallback string
+	// UsernameRequired fails reads, and skips broadcasts, when usernames cannot be loaded
+	UsernameRequired bool
+	// BroadcastEnabled publishes score changes to SSE viewers; turn-based or low-traffic boards can switch it off
+	BroadcastEnabled bool
+	// AllowScoreReset lets users delete their own score through DELETE /leaderboard/score
+	AllowScoreReset bool
+	// ShadowMaxScore accepts submissions above MaxScore, auditing them with a "shadow:" reason, to try a bound out first
+	ShadowMaxScore bool
+	// FreshnessHeaderEnabled adds X-Leaderboard-Updated-At, when the board last changed, to REST board reads
+	FreshnessHeaderEnabled bool
+}
+
+// StartupConfig holds dependency connection retry configuration
+type StartupConfig struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
 }
 
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	config := &Config{
 		Server: ServerConfig{
-			Port:         getEnv("SERVER_PORT", "8080"),
-			Host:         getEnv("SERVER_HOST", "0.0.0.0"),
+			Port: getEnv("SERVER_PORT", "8080"),
+			Host: getEnv("SERVER_HOST", "0.0.0.0"),
 			// Increased timeouts for SSE connections (Server-Sent Events)
 			// ReadTimeout: time to read request headers (SSE connections stay open)
-			ReadTimeout:  getDurationEnv("SERVER_READ_TIMEOUT", 10*time.Minute),
+			ReadTimeout: getDurationEnv("SERVER_READ_TIMEOUT", 10*time.Minute),
 			// WriteTimeout: time to write response (SSE sends data over time)
 			WriteTimeout: getDurationEnv("SERVER_WRITE_TIMEOUT", 10*time.Minute),
 			// IdleTimeout: time to keep idle connections open (cleanup dead connections)
 			IdleTimeout: getDurationEnv("SERVER_IDLE_TIMEOUT", 5*time.Minute),
+			// TrustedProxies: comma-separated IPs/CIDRs of load balancers in front of the server
+			TrustedProxies: getListEnv("SERVER_TRUSTED_PROXIES", nil),
+			// ExposeAPIVersion: report the build version on API responses (off by default)
+			ExposeAPIVersion: getBoolEnv("SERVER_EXPOSE_API_VERSION", false),
+			// Gzip: compress JSON responses above the threshold (SSE streams are never compressed)
+			GzipEnabled: getBoolEnv("SERVER_GZIP_ENABLED", false),
+			GzipMinSize: getIntEnv("SERVER_GZIP_MIN_SIZE", 1024),
 		},
 		Database: DatabaseConfig{
-			Host:            getEnv("DB_HOST", "localhost"),
-			Port:            getEnv("DB_PORT", "5432"),
-			User:            getEnv("DB_USER", "postgres"),
-			Password:        getEnv("DB_PASSWORD", "postgres"),
-			DBName:          getEnv("DB_NAME", "leaderboard"),
-			SSLMode:         getEnv("DB_SSLMODE", "disable"),
-			MaxConnections:  getIntEnv("DB_MAX_CONNECTIONS", 25),
-			MaxIdleConns:    getIntEnv("DB_MAX_IDLE_CONNS", 5),
-			ConnMaxLifetime: getDurationEnv("DB_CONN_MAX_LIFETIME", 5*time.Minute),
+			Host:                 getEnv("DB_HOST", "localhost"),
+			Port:                 getEnv("DB_PORT", "5432"),
+			User:                 getEnv("DB_USER", "postgres"),
+			Password:             getEnv("DB_PASSWORD", "postgres"),
+			DBName:               getEnv("DB_NAME", "leaderboard"),
+			SSLMode:              getEnv("DB_SSLMODE", "disable"),
+			MaxConnections:       getIntEnv("DB_MAX_CONNECTIONS", 25),
+			MaxIdleConns:         getIntEnv("DB_MAX_IDLE_CONNS", 5),
+			ConnMaxLifetime:      getDurationEnv("DB_CONN_MAX_LIFETIME", 5*time.Minute),
+			QueryTimeout:         getDurationEnv("DB_QUERY_TIMEOUT", 5*time.Second),
+			MaxQueriesPerRequest: getIntEnv("DB_MAX_QUERIES_PER_REQUEST", 4),
+			SlowQueryThreshold:   getDurationEnv("DB_SLOW_QUERY_THRESHOLD", 200*time.Millisecond),
 		},
 		Redis: RedisConfig{
 			Host:         getEnv("REDIS_HOST", "localhost"),
@@ -96,19 +217,137 @@ func Load() (*Config, error) {
 			MinIdleConns: getIntEnv("REDIS_MIN_IDLE_CONNS", 5),
 		},
 		JWT: JWTConfig{
-			SecretKey:     getEnv("JWT_SECRET_KEY", "your-secret-key-change-in-production"),
-			AccessExpiry:  getDurationEnv("JWT_ACCESS_EXPIRY", 15*time.Minute),
-			RefreshExpiry: getDurationEnv("JWT_REFRESH_EXPIRY", 7*24*time.Hour),
+			SecretKey:        getEnv("JWT_SECRET_KEY", "your-secret-key-change-in-production"),
+			KeyID:            getEnv("JWT_KEY_ID", ""),
+			VerificationKeys: getKeyMapEnv("JWT_VERIFICATION_KEYS"),
+			AccessExpiry:     getDurationEnv("JWT_ACCESS_EXPIRY", 15*time.Minute),
+			RefreshExpiry:    getDurationEnv("JWT_REFRESH_EXPIRY", 7*24*time.Hour),
+			Leeway:           getDurationEnv("JWT_LEEWAY", 30*time.Second),
+		},
+		Auth: AuthConfig{
+			IdempotentRegistration:    getBoolEnv("AUTH_IDEMPOTENT_REGISTRATION", false),
+			AllowedEmailDomains:       getListEnv("AUTH_ALLOWED_EMAIL_DOMAINS", nil),
+			DeniedEmailDomains:        getListEnv("AUTH_DENIED_EMAIL_DOMAINS", nil),
+			MinLoginDuration:          getDurationEnv("AUTH_MIN_LOGIN_DURATION", 0),
+			RegistrationsPerIPPerHour: getIntEnv("AUTH_REGISTRATIONS_PER_IP_PER_HOUR", 0),
 		},
 		Logger: LoggerConfig{
-			Level:  getEnv("LOG_LEVEL", "info"),
-			Pretty: getBoolEnv("LOG_PRETTY", true),
+			Level:      getEnv("LOG_LEVEL", "info"),
+			Pretty:     getBoolEnv("LOG_PRETTY", true),
+			Format:     getEnv("LOG_FORMAT", ""),
+			SampleRate: uint32(max(getIntEnv("LOG_SAMPLE_RATE", 0), 0)),
+			Output:     getEnv("LOG_OUTPUT", "stderr"),
+			// Rotation settings only apply when LOG_OUTPUT is a file path
+			FileMaxSizeMB:  getIntEnv("LOG_FILE_MAX_SIZE_MB", 100),
+			FileMaxBackups: getIntEnv("LOG_FILE_MAX_BACKUPS", 5),
+			FileMaxAgeDays: getIntEnv("LOG_FILE_MAX_AGE_DAYS", 28),
+			FileCompress:   getBoolEnv("LOG_FILE_COMPRESS", false),
+			// SkipPaths: comma-separated path prefixes matched against the full request path
+			SkipPaths: getListEnv("LOG_SKIP_PATHS", []string{"/health", "/version"}),
+		},
+		Leaderboard: LeaderboardConfig{
+			InactiveWindow:            getDurationEnv("LEADERBOARD_INACTIVE_WINDOW", 0),
+			EvictionInterval:          getDurationEnv("LEADERBOARD_EVICTION_INTERVAL", time.Minute),
+			LeaderWebhookURL:          getEnv("LEADERBOARD_LEADER_WEBHOOK_URL", ""),
+			WebhookMaxAttempts:        getIntEnv("LEADERBOARD_WEBHOOK_MAX_ATTEMPTS", 3),
+			WebhookBaseDelay:          getDurationEnv("LEADERBOARD_WEBHOOK_BASE_DELAY", time.Second),
+			RequireVerifiedEmail:      getBoolEnv("LEADERBOARD_REQUIRE_VERIFIED_EMAIL", false),
+			MaxStreamDuration:         getDurationEnv("LEADERBOARD_MAX_STREAM_DURATION", 0),
+			MaxIdleKeepAlives:         getIntEnv("LEADERBOARD_MAX_IDLE_KEEPALIVES", 0),
+			PollTimeout:               getDurationEnv("LEADERBOARD_POLL_TIMEOUT", 25*time.Second),
+			Order:                     getEnv("LEADERBOARD_ORDER", "desc"),
+			MaxScore:                  int64(getIntEnv("LEADERBOARD_MAX_SCORE", 0)),
+			MinScore:                  int64(getIntEnv("LEADERBOARD_MIN_SCORE", 0)),
+			AllowZeroScore:            getBoolEnv("LEADERBOARD_ALLOW_ZERO_SCORE", false),
+			MinBoardScore:             int64(getIntEnv("LEADERBOARD_MIN_BOARD_SCORE", 0)),
+			SubmissionSigningSecret:   getEnv("LEADERBOARD_SUBMISSION_SIGNING_SECRET", ""),
+			SubmissionSignatureMaxAge: getDurationEnv("LEADERBOARD_SUBMISSION_SIGNATURE_MAX_AGE", 5*time.Minute),
+			BoardTTL:                  getDurationEnv("LEADERBOARD_BOARD_TTL", 0),
+			DailySubmissionQuota:      getIntEnv("LEADERBOARD_DAILY_SUBMISSION_QUOTA", 0),
+			UsernameCacheTTL:          getDurationEnv("LEADERBOARD_USERNAME_CACHE_TTL", time.Minute),
+			UsernameFallback:          getEnv("LEADERBOARD_USERNAME_FALLBACK", ""),
+			UsernameRequired:          getBoolEnv("LEADERBOARD_USERNAME_REQUIRED", false),
+			BroadcastEnabled:          getBoolEnv("LEADERBOARD_BROADCAST_ENABLED", true),
+			FreshnessHeaderEnabled:    getBoolEnv("LEADERBOARD_FRESHNESS_HEADER_ENABLED", true),
+			AllowScoreReset:           getBoolEnv("LEADERBOARD_ALLOW_SCORE_RESET", false),
+			ShadowMaxScore:            getBoolEnv("LEADERBOARD_MAX_SCORE_SHADOW_MODE", false),
+		},
+		Startup: StartupConfig{
+			MaxAttempts: getIntEnv("STARTUP_MAX_ATTEMPTS", 5),
+			BaseDelay:   getDurationEnv("STARTUP_BASE_DELAY", time.Second),
 		},
 	}
 
 	return config, nil
 }
 
+// Validate checks required settings and value ranges so a misconfigured server fails at startup instead of
+// on its first request. It reports every problem found, each naming the environment variable to fix.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Server.Port != "", "SERVER_PORT must be set")
+	check(c.Server.ReadTimeout >= 0, "SERVER_READ_TIMEOUT must not be negative, got %s", c.Server.ReadTimeout)
+	check(c.Server.WriteTimeout >= 0, "SERVER_WRITE_TIMEOUT must not be negative, got %s", c.Server.WriteTimeout)
+	check(c.Server.IdleTimeout >= 0, "SERVER_IDLE_TIMEOUT must not be negative, got %s", c.Server.IdleTimeout)
+	check(c.Server.GzipMinSize >= 0, "SERVER_GZIP_MIN_SIZE must not be negative, got %d", c.Server.GzipMinSize)
+	check(c.Auth.MinLoginDuration >= 0, "AUTH_MIN_LOGIN_DURATION must not be negative, got %s", c.Auth.MinLoginDuration)
+	check(c.Auth.RegistrationsPerIPPerHour >= 0, "AUTH_REGISTRATIONS_PER_IP_PER_HOUR must not be negative, got %d", c.Auth.RegistrationsPerIPPerHour)
+
+	check(c.Database.Host != "", "DB_HOST must be set")
+	check(c.Database.DBName != "", "DB_NAME must be set")
+	check(c.Database.MaxConnections > 0, "DB_MAX_CONNECTIONS must be positive, got %d", c.Database.MaxConnections)
+	check(c.Database.MaxIdleConns >= 0, "DB_MAX_IDLE_CONNS must not be negative, got %d", c.Database.MaxIdleConns)
+	check(c.Database.QueryTimeout >= 0, "DB_QUERY_TIMEOUT must not be negative, got %s", c.Database.QueryTimeout)
+	check(c.Database.MaxQueriesPerRequest >= 0, "DB_MAX_QUERIES_PER_REQUEST must not be negative, got %d", c.Database.MaxQueriesPerRequest)
+	check(c.Database.SlowQueryThreshold >= 0, "DB_SLOW_QUERY_THRESHOLD must not be negative, got %s", c.Database.SlowQueryThreshold)
+
+	check(c.Redis.Host != "", "REDIS_HOST must be set")
+	check(c.Redis.DB >= 0, "REDIS_DB must not be negative, got %d", c.Redis.DB)
+	check(c.Redis.PoolSize > 0, "REDIS_POOL_SIZE must be positive, got %d", c.Redis.PoolSize)
+	check(c.Redis.MinIdleConns >= 0, "REDIS_MIN_IDLE_CONNS must not be negative, got %d", c.Redis.MinIdleConns)
+
+	check(c.JWT.SecretKey != "", "JWT_SECRET_KEY must be set")
+	for id, secret := range c.JWT.VerificationKeys {
+		check(secret != "", "JWT_VERIFICATION_KEYS entry %q has an empty secret", id)
+	}
+	check(c.JWT.AccessExpiry > 0, "JWT_ACCESS_EXPIRY must be positive, got %s", c.JWT.AccessExpiry)
+	check(c.JWT.RefreshExpiry >= c.JWT.AccessExpiry,
+		"JWT_REFRESH_EXPIRY (%s) must not be shorter than JWT_ACCESS_EXPIRY (%s)", c.JWT.RefreshExpiry, c.JWT.AccessExpiry)
+	check(c.JWT.Leeway >= 0, "JWT_LEEWAY must not be negative, got %s", c.JWT.Leeway)
+
+	check(c.Logger.Format == "" || c.Logger.Format == "json" || c.Logger.Format == "console",
+		"LOG_FORMAT must be json or console, got %q", c.Logger.Format)
+
+	check(c.Leaderboard.Order == "desc" || c.Leaderboard.Order == "asc",
+		"LEADERBOARD_ORDER must be desc or asc, got %q", c.Leaderboard.Order)
+	check(c.Leaderboard.InactiveWindow >= 0, "LEADERBOARD_INACTIVE_WINDOW must not be negative, got %s", c.Leaderboard.InactiveWindow)
+	check(c.Leaderboard.InactiveWindow == 0 || c.Leaderboard.EvictionInterval > 0,
+		"LEADERBOARD_EVICTION_INTERVAL must be positive when LEADERBOARD_INACTIVE_WINDOW is set, got %s", c.Leaderboard.EvictionInterval)
+	check(c.Leaderboard.LeaderWebhookURL == "" || c.Leaderboard.WebhookMaxAttempts > 0,
+		"LEADERBOARD_WEBHOOK_MAX_ATTEMPTS must be positive when LEADERBOARD_LEADER_WEBHOOK_URL is set, got %d", c.Leaderboard.WebhookMaxAttempts)
+	check(c.Leaderboard.MaxStreamDuration >= 0, "LEADERBOARD_MAX_STREAM_DURATION must not be negative, got %s", c.Leaderboard.MaxStreamDuration)
+	check(c.Leaderboard.MaxIdleKeepAlives >= 0, "LEADERBOARD_MAX_IDLE_KEEPALIVES must not be negative, got %d", c.Leaderboard.MaxIdleKeepAlives)
+	check(c.Leaderboard.PollTimeout >= 0, "LEADERBOARD_POLL_TIMEOUT must not be negative, got %s", c.Leaderboard.PollTimeout)
+	check(c.Leaderboard.MaxScore >= 0, "LEADERBOARD_MAX_SCORE must not be negative, got %d", c.Leaderboard.MaxScore)
+	check(c.Leaderboard.MaxScore == 0 || c.Leaderboard.MinScore <= c.Leaderboard.MaxScore,
+		"LEADERBOARD_MIN_SCORE (%d) must not exceed LEADERBOARD_MAX_SCORE (%d)", c.Leaderboard.MinScore, c.Leaderboard.MaxScore)
+	check(c.Leaderboard.MinBoardScore >= 0, "LEADERBOARD_MIN_BOARD_SCORE must not be negative, got %d", c.Leaderboard.MinBoardScore)
+	check(c.Leaderboard.SubmissionSignatureMaxAge > 0, "LEADERBOARD_SUBMISSION_SIGNATURE_MAX_AGE must be positive, got %s", c.Leaderboard.SubmissionSignatureMaxAge)
+	check(c.Leaderboard.BoardTTL >= 0, "LEADERBOARD_BOARD_TTL must not be negative, got %s", c.Leaderboard.BoardTTL)
+	check(c.Leaderboard.DailySubmissionQuota >= 0, "LEADERBOARD_DAILY_SUBMISSION_QUOTA must not be negative, got %d", c.Leaderboard.DailySubmissionQuota)
+	check(c.Leaderboard.UsernameCacheTTL >= 0, "LEADERBOARD_USERNAME_CACHE_TTL must not be negative, got %s", c.Leaderboard.UsernameCacheTTL)
+
+	check(c.Startup.BaseDelay >= 0, "STARTUP_BASE_DELAY must not be negative, got %s", c.Startup.BaseDelay)
+
+	return errors.Join(errs...)
+}
+
 // getEnv gets an environment variable or returns a default value
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -147,12 +386,52 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+// getListEnv gets a comma-separated environment variable as a list or returns a default value
+func getListEnv(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getKeyMapEnv gets a comma-separated list of "id:secret" pairs as a map; an entry without ":" has an empty ID
+func getKeyMapEnv(key string) map[string]string {
+	keys := make(map[string]string)
+	for _, item := range getListEnv(key, nil) {
+		id, secret, found := strings.Cut(item, ":")
+		if !found {
+			id, secret = "", item
+		}
+		keys[id] = secret
+	}
+	return keys
+}
+
 // GetDSN returns the database connection string
 func (c *DatabaseConfig) GetDSN() string {
 	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		c.Host, c.Port, c.User, c.Password, c.DBName, c.SSLMode)
 }
 
+// GetFormat returns the log output format, defaulting to console when Pretty is set and JSON otherwise
+func (c *LoggerConfig) GetFormat() string {
+	if c.Format != "" {
+		return c.Format
+	}
+	if c.Pretty {
+		return "console"
+	}
+	return "json"
+}
+
 // GetAddr returns the server address
 func (c *ServerConfig) GetAddr() string {
 	return fmt.Sprintf("%s:%s", c.Host, c.Port)
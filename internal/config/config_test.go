@@ -0,0 +1,77 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate_WhenDefaults_ShouldAccept(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = cfg.Validate()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestConfig_Validate_WhenJWTSecretMissing_ShouldReject(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	cfg, err := Load()
+	require.NoError(t, err)
+	cfg.JWT.SecretKey = ""
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = cfg.Validate()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorContains(t, err, "JWT_SECRET_KEY must be set")
+}
+
+func TestConfig_Validate_WhenExpiryInvalid_ShouldRejectWithEveryProblem(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	t.Setenv("JWT_ACCESS_EXPIRY", "0s")
+	t.Setenv("JWT_REFRESH_EXPIRY", "-1h")
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = cfg.Validate()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorContains(t, err, "JWT_ACCESS_EXPIRY must be positive, got 0s")
+	require.ErrorContains(t, err, "JWT_REFRESH_EXPIRY (-1h0m0s) must not be shorter than JWT_ACCESS_EXPIRY (0s)")
+}
+
+func TestConfig_Validate_WhenPoolSizeNegative_ShouldReject(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	cfg, err := Load()
+	require.NoError(t, err)
+	cfg.Redis.PoolSize = -1
+	cfg.Leaderboard.InactiveWindow = time.Hour
+	cfg.Leaderboard.EvictionInterval = 0
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = cfg.Validate()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorContains(t, err, "REDIS_POOL_SIZE must be positive, got -1")
+	require.ErrorContains(t, err, "LEADERBOARD_EVICTION_INTERVAL must be positive")
+}
+
+func TestConfig_Validate_WhenOrderUnknown_ShouldReject(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	t.Setenv("LEADERBOARD_ORDER", "sideways")
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = cfg.Validate()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorContains(t, err, `LEADERBOARD_ORDER must be desc or asc, got "sideways"`)
+}
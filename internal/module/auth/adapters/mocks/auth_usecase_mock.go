@@ -57,6 +57,52 @@ func (mr *MockAuthUseCaseMockRecorder) GetCurrentUser(ctx, userID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*MockAuthUseCase)(nil).GetCurrentUser), ctx, userID)
 }
 
+// GetPublicProfile mocks base method.
+func (m *MockAuthUseCase) GetPublicProfile(ctx context.Context, userID string) (*domain.PublicUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicProfile", ctx, userID)
+	ret0, _ := ret[0].(*domain.PublicUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicProfile indicates an expected call of GetPublicProfile.
+func (mr *MockAuthUseCaseMockRecorder) GetPublicProfile(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicProfile", reflect.TypeOf((*MockAuthUseCase)(nil).GetPublicProfile), ctx, userID)
+}
+
+// IsAdmin mocks base method.
+func (m *MockAuthUseCase) IsAdmin(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockAuthUseCaseMockRecorder) IsAdmin(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockAuthUseCase)(nil).IsAdmin), ctx, userID)
+}
+
+// ListUsers mocks base method.
+func (m *MockAuthUseCase) ListUsers(ctx context.Context, limit, offset int64) ([]*domain.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockAuthUseCaseMockRecorder) ListUsers(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockAuthUseCase)(nil).ListUsers), ctx, limit, offset)
+}
+
 // Login mocks base method.
 func (m *MockAuthUseCase) Login(ctx context.Context, req application.LoginRequest) (*domain.User, *domain.TokenPair, error) {
 	m.ctrl.T.Helper()
@@ -104,6 +150,20 @@ func (mr *MockAuthUseCaseMockRecorder) Register(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthUseCase)(nil).Register), ctx, req)
 }
 
+// ResendEmailVerification mocks base method.
+func (m *MockAuthUseCase) ResendEmailVerification(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendEmailVerification", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendEmailVerification indicates an expected call of ResendEmailVerification.
+func (mr *MockAuthUseCaseMockRecorder) ResendEmailVerification(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendEmailVerification", reflect.TypeOf((*MockAuthUseCase)(nil).ResendEmailVerification), ctx, userID)
+}
+
 // ValidateToken mocks base method.
 func (m *MockAuthUseCase) ValidateToken(ctx context.Context, token string) (string, error) {
 	m.ctrl.T.Helper()
@@ -118,3 +178,17 @@ func (mr *MockAuthUseCaseMockRecorder) ValidateToken(ctx, token any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockAuthUseCase)(nil).ValidateToken), ctx, token)
 }
+
+// VerifyEmail mocks base method.
+func (m *MockAuthUseCase) VerifyEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAuthUseCaseMockRecorder) VerifyEmail(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthUseCase)(nil).VerifyEmail), ctx, token)
+}
@@ -2,6 +2,7 @@
 package v1
 
 import (
+	"context"
 	"errors"
 
 	"real-time-leaderboard/internal/module/auth/domain"
@@ -33,10 +34,27 @@ func toAPIError(err error) *response.APIError {
 	if errors.Is(err, domain.ErrInvalidCredentials) {
 		return response.NewUnauthorizedError("Invalid credentials")
 	}
+	if errors.Is(err, domain.ErrEmailDomainNotAllowed) {
+		return response.NewForbiddenError("Registration is not allowed for this email domain")
+	}
+	if errors.Is(err, domain.ErrInvalidVerificationToken) {
+		return response.NewBadRequestError("Invalid or already used verification token")
+	}
+	if errors.Is(err, domain.ErrEmailAlreadyVerified) {
+		return response.NewConflictError("Email already verified")
+	}
+	if errors.Is(err, domain.ErrEmailVerificationOff) {
+		return response.NewBadRequestError("Email verification is not enabled")
+	}
 	if errors.Is(err, domain.ErrInvalidToken) {
 		return response.NewUnauthorizedError("Invalid or expired token")
 	}
 
+	// Repository calls that outlived the use-case query timeout
+	if errors.Is(err, context.DeadlineExceeded) {
+		return response.NewTimeoutError("Request timed out")
+	}
+
 	// If it's already an APIError, return it as-is
 	if apiErr, ok := err.(*response.APIError); ok {
 		return apiErr
@@ -2,9 +2,12 @@
 package v1
 
 import (
+	"slices"
+
 	"real-time-leaderboard/internal/module/auth/application"
 	"real-time-leaderboard/internal/shared/logger"
 	"real-time-leaderboard/internal/shared/middleware"
+	"real-time-leaderboard/internal/shared/request"
 	"real-time-leaderboard/internal/shared/response"
 	"real-time-leaderboard/internal/shared/validator"
 
@@ -121,6 +124,57 @@ func (h *Handler) RefreshToken(c *gin.Context) {
 	response.Success(c, gin.H{"token": tokenPair}, "Token refreshed successfully")
 }
 
+// VerifyEmail handles email verification with a token delivered to the user
+func (h *Handler) VerifyEmail(c *gin.Context) {
+	var req struct {
+		Token string `json:"token" validate:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := h.authUseCase.VerifyEmail(c.Request.Context(), req.Token); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, nil, "Email verified successfully")
+}
+
+// ResendEmailVerification issues a new email verification token to the authenticated user
+func (h *Handler) ResendEmailVerification(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		apiErr := response.NewUnauthorizedError("User ID not found in context")
+		h.logger.Error(c.Request.Context(), apiErr.Error())
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := h.authUseCase.ResendEmailVerification(c.Request.Context(), userID); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, nil, "Verification email sent")
+}
+
 // GetCurrentUser returns the current authenticated user's information
 func (h *Handler) GetCurrentUser(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
@@ -142,14 +196,79 @@ func (h *Handler) GetCurrentUser(c *gin.Context) {
 	response.Success(c, user, "User retrieved successfully")
 }
 
-// RegisterPublicRoutes registers public auth routes (no auth required)
-func (h *Handler) RegisterPublicRoutes(router *gin.RouterGroup) {
+// GetPublicProfile returns the public profile of any user by ID
+func (h *Handler) GetPublicProfile(c *gin.Context) {
+	var req struct {
+		ID string `uri:"id" json:"id" validate:"required,uuid"`
+	}
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	profile, err := h.authUseCase.GetPublicProfile(c.Request.Context(), req.ID)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, profile, "User profile retrieved successfully")
+}
+
+// ListUsers handles GET /admin/users with pagination; password hashes are never returned
+func (h *Handler) ListUsers(c *gin.Context) {
+	var query request.Pagination
+	if err := c.ShouldBindQuery(&query); err != nil {
+		valErr := &validator.ValidationError{Message: "limit and offset must be integers", Err: err}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := query.Validate(request.MaxLimit); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	users, total, err := h.authUseCase.ListUsers(c.Request.Context(), query.Limit, query.Offset)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.SuccessWithMeta(c, users, "Users retrieved successfully", response.NewPagination(query.Offset, query.Limit, total))
+}
+
+// RegisterPublicRoutes registers public auth routes (no auth required).
+// registerMiddleware runs before registration only, e.g. to throttle account creation per IP.
+func (h *Handler) RegisterPublicRoutes(router *gin.RouterGroup, registerMiddleware ...gin.HandlerFunc) {
 	auth := router.Group("/auth")
 	{
-		auth.POST("/register", h.Register)
+		auth.POST("/register", append(slices.Clone(registerMiddleware), h.Register)...)
 		auth.POST("/login", h.Login)
 		auth.POST("/refresh", h.RefreshToken)
+		auth.POST("/verify-email", h.VerifyEmail)
 	}
+
+	router.GET("/users/:id", h.GetPublicProfile)
 }
 
 // RegisterProtectedRoutes registers protected auth routes (requires authentication)
@@ -157,5 +276,11 @@ func (h *Handler) RegisterProtectedRoutes(router *gin.RouterGroup) {
 	auth := router.Group("/auth")
 	{
 		auth.GET("/me", h.GetCurrentUser)
+		auth.POST("/verify-email/resend", h.ResendEmailVerification)
 	}
 }
+
+// RegisterAdminRoutes registers admin user routes (auth and admin role required)
+func (h *Handler) RegisterAdminRoutes(router *gin.RouterGroup) {
+	router.GET("/users", h.ListUsers)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -59,6 +60,45 @@ func TestHandler_Register_WhenValidJSON_ShouldReturn201AndUserAndToken(t *testin
 	require.NotNil(t, body.Data)
 }
 
+func TestHandler_Register_WhenUserHasPasswordHash_ShouldNotSerializePassword(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().
+		Register(gomock.Any(), gomock.Any()).
+		Return(
+			&domain.User{ID: "user-1", Username: "alice", Email: "alice@example.com", Password: "$2a$10$hashedpassword"},
+			&domain.TokenPair{AccessToken: "at", RefreshToken: "rt", ExpiresIn: 3600},
+			nil,
+		).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewBufferString(`{"username":"alice","email":"alice@example.com","password":"secure123"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	h := NewHandler(mockAuth, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.Register(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusCreated, w.Code)
+	var body struct {
+		Data struct {
+			User map[string]any `json:"user"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, "alice", body.Data.User["username"])
+	require.NotContains(t, body.Data.User, "password")
+	require.NotContains(t, w.Body.String(), "$2a$10$hashedpassword")
+}
+
 func TestHandler_Register_WhenMissingRequiredFields_ShouldReturn400(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	gin.SetMode(gin.TestMode)
@@ -158,6 +198,45 @@ func TestHandler_Login_WhenValidJSON_ShouldReturn200AndUserAndToken(t *testing.T
 	require.Equal(t, "Login successful", body.Message)
 }
 
+func TestHandler_Login_WhenUserHasPasswordHash_ShouldNotSerializePassword(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().
+		Login(gomock.Any(), gomock.Any()).
+		Return(
+			&domain.User{ID: "user-1", Username: "alice", Email: "alice@example.com", Password: "$2a$10$hashedpassword"},
+			&domain.TokenPair{AccessToken: "at", RefreshToken: "rt", ExpiresIn: 3600},
+			nil,
+		).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBufferString(`{"username":"alice","password":"secure123"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	h := NewHandler(mockAuth, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.Login(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data struct {
+			User map[string]any `json:"user"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, "alice", body.Data.User["username"])
+	require.NotContains(t, body.Data.User, "password")
+	require.NotContains(t, w.Body.String(), "$2a$10$hashedpassword")
+}
+
 func TestHandler_Login_WhenUseCaseReturnsUnauthorized_ShouldReturn401(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	gin.SetMode(gin.TestMode)
@@ -333,3 +412,215 @@ func TestHandler_GetCurrentUser_WhenUseCaseReturnsError_ShouldReturnMappedError(
 	require.False(t, body.Success)
 	require.Equal(t, string(response.CodeNotFound), body.Error.Code)
 }
+
+func TestHandler_ResendEmailVerification_WhenAlreadyVerified_ShouldReturn409(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().
+		ResendEmailVerification(gomock.Any(), "user-123").
+		Return(domain.ErrEmailAlreadyVerified).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/auth/verify-email/resend", nil)
+	c.Set("user_id", "user-123")
+
+	h := NewHandler(mockAuth, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ResendEmailVerification(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusConflict, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.False(t, body.Success)
+	require.Equal(t, string(response.CodeConflict), body.Error.Code)
+}
+
+func TestHandler_GetPublicProfile_WhenUserExists_ShouldReturn200WithoutEmailOrPassword(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := "00000000-0000-0000-0000-000000000001"
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().
+		GetPublicProfile(gomock.Any(), userID).
+		Return(&domain.PublicUser{ID: userID, Username: "alice", CreatedAt: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}, nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/users/"+userID, nil)
+	c.Params = gin.Params{{Key: "id", Value: userID}}
+
+	h := NewHandler(mockAuth, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetPublicProfile(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Success bool                   `json:"success"`
+		Data    map[string]interface{} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Success)
+	require.Equal(t, "alice", body.Data["username"])
+	require.Contains(t, body.Data, "created_at")
+	require.NotContains(t, body.Data, "email")
+	require.NotContains(t, body.Data, "password")
+	require.NotContains(t, body.Data, "password_hash")
+}
+
+func TestHandler_GetPublicProfile_WhenUserNotFound_ShouldReturn404(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := "00000000-0000-0000-0000-000000000404"
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().
+		GetPublicProfile(gomock.Any(), userID).
+		Return(nil, domain.ErrUserNotFound).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/users/"+userID, nil)
+	c.Params = gin.Params{{Key: "id", Value: userID}}
+
+	h := NewHandler(mockAuth, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetPublicProfile(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusNotFound, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeNotFound), body.Error.Code)
+}
+
+func TestHandler_GetPublicProfile_WhenIDNotUUID_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().GetPublicProfile(gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/users/not-a-uuid", nil)
+	c.Params = gin.Params{{Key: "id", Value: "not-a-uuid"}}
+
+	h := NewHandler(mockAuth, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetPublicProfile(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
+}
+
+func TestHandler_ListUsers_WhenValidPagination_ShouldReturn200WithUsersAndMeta(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().
+		ListUsers(gomock.Any(), int64(2), int64(4)).
+		Return([]*domain.User{
+			{ID: "user-5", Username: "eve", Email: "eve@example.com", Role: domain.RoleUser},
+		}, int64(5), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/users?limit=2&offset=4", nil)
+
+	h := NewHandler(mockAuth, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ListUsers(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Success bool                `json:"success"`
+		Data    []domain.User       `json:"data"`
+		Meta    response.Pagination `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Success)
+	require.Len(t, body.Data, 1)
+	require.Equal(t, "eve", body.Data[0].Username)
+	require.Equal(t, response.Pagination{Page: 3, Limit: 2, Total: 5, TotalPages: 3}, body.Meta)
+}
+
+func TestHandler_ListUsers_WhenUserHasPassword_ShouldNotExposeIt(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().
+		ListUsers(gomock.Any(), int64(10), int64(0)).
+		Return([]*domain.User{
+			{ID: "user-1", Username: "alice", Email: "alice@example.com", Password: "$2a$10$secret-hash", Role: domain.RoleUser},
+		}, int64(1), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+
+	h := NewHandler(mockAuth, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ListUsers(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotContains(t, w.Body.String(), "secret-hash")
+	require.NotContains(t, w.Body.String(), "password")
+}
+
+func TestHandler_ListUsers_WhenLimitAboveMax_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := authmocks.NewMockAuthUseCase(ctrl)
+	mockAuth.EXPECT().ListUsers(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/users?limit=101", nil)
+
+	h := NewHandler(mockAuth, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ListUsers(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -2,12 +2,19 @@
 package application
 
 //go:generate mockgen -destination=../infrastructure/mocks/jwt_manager_mock.go -package=mocks real-time-leaderboard/internal/module/auth/application JWTManager
+//go:generate mockgen -destination=../infrastructure/mocks/verification_sender_mock.go -package=mocks real-time-leaderboard/internal/module/auth/application VerificationSender
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"time"
 
 	"real-time-leaderboard/internal/module/auth/domain"
+	"real-time-leaderboard/internal/shared/database"
 	"real-time-leaderboard/internal/shared/logger"
 
 	"golang.org/x/crypto/bcrypt"
@@ -22,13 +29,41 @@ type AuthUseCase interface {
 	ValidateToken(ctx context.Context, token string) (string, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*domain.TokenPair, error)
 	GetCurrentUser(ctx context.Context, userID string) (*domain.User, error)
+	GetPublicProfile(ctx context.Context, userID string) (*domain.PublicUser, error)
+	ListUsers(ctx context.Context, limit, offset int64) ([]*domain.User, int64, error)
+	VerifyEmail(ctx context.Context, token string) error
+	ResendEmailVerification(ctx context.Context, userID string) error
+	IsAdmin(ctx context.Context, userID string) (bool, error)
 }
 
+// dummyPasswordHash is compared against when a login names no user, so that case costs a bcrypt comparison
+// at the default cost like a wrong password does and response times do not reveal which usernames exist
+const dummyPasswordHash = "$2a$10$crautdrlkqEpco9pRBE4guIXnNP920tBQp2iC5nd0ZNz7QQJL6j0e"
+
 // authUseCase implements AuthUseCase interface
 type authUseCase struct {
-	userRepo UserRepository
-	jwtMgr   JWTManager
-	logger   *logger.Logger
+	userRepo           UserRepository
+	jwtMgr             JWTManager
+	verificationSender VerificationSender
+	config             AuthConfig
+	comparePassword    func(hashedPassword, password []byte) error
+	logger             *logger.Logger
+}
+
+// AuthConfig holds auth use case behavior configuration
+type AuthConfig struct {
+	// QueryTimeout bounds the repository calls of each operation (0 disables)
+	QueryTimeout time.Duration
+	// IdempotentRegistration answers a repeated registration whose username, email and password match
+	// an existing user with that user and a fresh token pair instead of a conflict
+	IdempotentRegistration bool
+	// AllowedEmailDomains restricts registration to these email domains (empty allows all);
+	// a "*." prefix also matches any subdomain
+	AllowedEmailDomains []string
+	// DeniedEmailDomains rejects registration from these email domains, using the same matching
+	DeniedEmailDomains []string
+	// MinLoginDuration pads every login, successful or not, to take at least this long (0 disables)
+	MinLoginDuration time.Duration
 }
 
 // JWTManager interface for JWT operations
@@ -37,14 +72,23 @@ type JWTManager interface {
 	ValidateToken(token string) (string, error)
 }
 
-// NewAuthUseCase creates a new auth use case
+// VerificationSender delivers email verification tokens to users
+type VerificationSender interface {
+	SendVerification(ctx context.Context, email, token string) error
+}
+
+// NewAuthUseCase creates a new auth use case.
+// verificationSender may be nil to skip issuing email verification tokens on registration.
 //
 //nolint:revive // unexported-return: intentional design - accept interface, return struct
-func NewAuthUseCase(userRepo UserRepository, jwtMgr JWTManager, l *logger.Logger) *authUseCase {
+func NewAuthUseCase(userRepo UserRepository, jwtMgr JWTManager, verificationSender VerificationSender, cfg AuthConfig, l *logger.Logger) *authUseCase {
 	return &authUseCase{
-		userRepo: userRepo,
-		jwtMgr:   jwtMgr,
-		logger:   l,
+		userRepo:           userRepo,
+		jwtMgr:             jwtMgr,
+		verificationSender: verificationSender,
+		config:             cfg,
+		comparePassword:    bcrypt.CompareHashAndPassword,
+		logger:             l,
 	}
 }
 
@@ -63,6 +107,13 @@ type LoginRequest struct {
 
 // Register registers a new user
 func (uc *authUseCase) Register(ctx context.Context, req RegisterRequest) (*domain.User, *domain.TokenPair, error) {
+	if !uc.isEmailDomainAllowed(req.Email) {
+		return nil, nil, domain.ErrEmailDomainNotAllowed
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
 	// Check if username already exists
 	existingUser, err := uc.userRepo.GetByUsername(ctx, req.Username)
 	if err != nil {
@@ -70,6 +121,9 @@ func (uc *authUseCase) Register(ctx context.Context, req RegisterRequest) (*doma
 		return nil, nil, fmt.Errorf("failed to check username existence: %w", err)
 	}
 	if existingUser != nil {
+		if uc.config.IdempotentRegistration && isSameRegistration(existingUser, req) {
+			return uc.replayRegistration(ctx, existingUser)
+		}
 		return nil, nil, fmt.Errorf("%w: username", domain.ErrUserAlreadyExists)
 	}
 
@@ -102,6 +156,13 @@ func (uc *authUseCase) Register(ctx context.Context, req RegisterRequest) (*doma
 		return nil, nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
+	// Verification failures are logged only so registration still succeeds; the user can ask for a new token
+	if uc.verificationSender != nil {
+		if err := uc.sendEmailVerification(ctx, user); err != nil {
+			uc.logger.Warnf(ctx, "Failed to issue email verification: %v", err)
+		}
+	}
+
 	// Generate tokens
 	tokenPair, err := uc.jwtMgr.GenerateTokenPair(user.ID)
 	if err != nil {
@@ -113,8 +174,67 @@ func (uc *authUseCase) Register(ctx context.Context, req RegisterRequest) (*doma
 	return user, tokenPair, nil
 }
 
-// Login authenticates a user
+// replayRegistration answers a retried registration with the existing user and a fresh token pair
+func (uc *authUseCase) replayRegistration(ctx context.Context, user *domain.User) (*domain.User, *domain.TokenPair, error) {
+	tokenPair, err := uc.jwtMgr.GenerateTokenPair(user.ID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to generate tokens: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate tokens: %w", err)
+	}
+
+	uc.logger.Infof(ctx, "Registration replayed for existing user: %s", user.ID)
+	return user, tokenPair, nil
+}
+
+// isSameRegistration reports whether req repeats the registration that created user
+func isSameRegistration(user *domain.User, req RegisterRequest) bool {
+	if user.Username != req.Username || user.Email != req.Email {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)) == nil
+}
+
+// isEmailDomainAllowed checks the domain of email against the configured allow and deny lists
+func (uc *authUseCase) isEmailDomainAllowed(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return len(uc.config.AllowedEmailDomains) == 0
+	}
+	emailDomain := strings.ToLower(email[at+1:])
+
+	if matchesEmailDomain(emailDomain, uc.config.DeniedEmailDomains) {
+		return false
+	}
+	return len(uc.config.AllowedEmailDomains) == 0 || matchesEmailDomain(emailDomain, uc.config.AllowedEmailDomains)
+}
+
+// matchesEmailDomain reports whether emailDomain equals one of patterns or, for "*.example.com"
+// patterns, is a subdomain of example.com
+func matchesEmailDomain(emailDomain string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(emailDomain, "."+suffix) {
+				return true
+			}
+			continue
+		}
+		if emailDomain == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// Login authenticates a user.
+// An unknown username costs the same bcrypt comparison as a wrong password, and with MinLoginDuration
+// every attempt takes at least that long, so response times do not reveal which usernames exist.
 func (uc *authUseCase) Login(ctx context.Context, req LoginRequest) (*domain.User, *domain.TokenPair, error) {
+	defer uc.padLoginDuration(ctx, time.Now())
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
 	// Get user by username
 	user, err := uc.userRepo.GetByUsername(ctx, req.Username)
 	if err != nil {
@@ -122,11 +242,12 @@ func (uc *authUseCase) Login(ctx context.Context, req LoginRequest) (*domain.Use
 		return nil, nil, fmt.Errorf("failed to get user: %w", err)
 	}
 	if user == nil {
+		_ = uc.comparePassword([]byte(dummyPasswordHash), []byte(req.Password))
 		return nil, nil, domain.ErrInvalidCredentials
 	}
 
 	// Verify password
-	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+	if err := uc.comparePassword([]byte(user.Password), []byte(req.Password)); err != nil {
 		return nil, nil, domain.ErrInvalidCredentials
 	}
 
@@ -141,8 +262,26 @@ func (uc *authUseCase) Login(ctx context.Context, req LoginRequest) (*domain.Use
 	return user, tokenPair, nil
 }
 
+// padLoginDuration sleeps until MinLoginDuration has passed since start, or ctx is done
+func (uc *authUseCase) padLoginDuration(ctx context.Context, start time.Time) {
+	remaining := uc.config.MinLoginDuration - time.Since(start)
+	if remaining <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(remaining)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
 // ValidateToken validates a JWT token and returns the user ID
 func (uc *authUseCase) ValidateToken(ctx context.Context, token string) (string, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
 	userID, err := uc.jwtMgr.ValidateToken(token)
 	if err != nil {
 		return "", fmt.Errorf("%w: %v", domain.ErrInvalidToken, err)
@@ -163,6 +302,9 @@ func (uc *authUseCase) ValidateToken(ctx context.Context, token string) (string,
 
 // RefreshToken refreshes an access token using a refresh token
 func (uc *authUseCase) RefreshToken(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
 	userID, err := uc.jwtMgr.ValidateToken(refreshToken)
 	if err != nil {
 		return nil, fmt.Errorf("%w: refresh token: %v", domain.ErrInvalidToken, err)
@@ -190,6 +332,9 @@ func (uc *authUseCase) RefreshToken(ctx context.Context, refreshToken string) (*
 
 // GetCurrentUser retrieves the current user by ID
 func (uc *authUseCase) GetCurrentUser(ctx context.Context, userID string) (*domain.User, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
 	user, err := uc.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		uc.logger.Errorf(ctx, "Failed to get user: %v", err)
@@ -201,3 +346,122 @@ func (uc *authUseCase) GetCurrentUser(ctx context.Context, userID string) (*doma
 
 	return user, nil
 }
+
+// GetPublicProfile retrieves the non-sensitive profile of any user by ID
+func (uc *authUseCase) GetPublicProfile(ctx context.Context, userID string) (*domain.PublicUser, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	profile, err := uc.userRepo.GetPublicProfile(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get public profile: %v", err)
+		return nil, fmt.Errorf("failed to get public profile: %w", err)
+	}
+	if profile == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return profile, nil
+}
+
+// ListUsers retrieves a page of registered users and the total count for admins.
+// Password hashes are never loaded.
+func (uc *authUseCase) ListUsers(ctx context.Context, limit, offset int64) ([]*domain.User, int64, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	users, total, err := uc.userRepo.List(ctx, limit, offset)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to list users: %v", err)
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	return users, total, nil
+}
+
+// IsAdmin reports whether the user has the admin role; unknown users are not admins
+func (uc *authUseCase) IsAdmin(ctx context.Context, userID string) (bool, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get user: %v", err)
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return false, nil
+	}
+
+	return user.Role == domain.RoleAdmin, nil
+}
+
+// VerifyEmail marks the owner of a verification token as verified; tokens are single-use
+func (uc *authUseCase) VerifyEmail(ctx context.Context, token string) error {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	verified, err := uc.userRepo.VerifyEmailByToken(ctx, hashVerificationToken(token))
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to verify email: %v", err)
+		return fmt.Errorf("failed to verify email: %w", err)
+	}
+	if !verified {
+		return domain.ErrInvalidVerificationToken
+	}
+
+	return nil
+}
+
+// ResendEmailVerification issues a new verification token to an unverified user, replacing any pending one.
+// Users registered before verification was enabled have no token, so this is how they get one.
+func (uc *authUseCase) ResendEmailVerification(ctx context.Context, userID string) error {
+	if uc.verificationSender == nil {
+		return domain.ErrEmailVerificationOff
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get user: %v", err)
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+	if user.EmailVerified {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	if err := uc.sendEmailVerification(ctx, user); err != nil {
+		uc.logger.Errorf(ctx, "Failed to issue email verification: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// sendEmailVerification issues a verification token and delivers it; only the token hash is stored
+func (uc *authUseCase) sendEmailVerification(ctx context.Context, user *domain.User) error {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Errorf("failed to generate email verification token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	if err := uc.userRepo.SetEmailVerificationToken(ctx, user.ID, hashVerificationToken(token)); err != nil {
+		return fmt.Errorf("failed to store email verification token: %w", err)
+	}
+	if err := uc.verificationSender.SendVerification(ctx, user.Email, token); err != nil {
+		return fmt.Errorf("failed to send email verification: %w", err)
+	}
+
+	return nil
+}
+
+func hashVerificationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
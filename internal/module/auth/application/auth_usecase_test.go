@@ -3,7 +3,9 @@ package application
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -48,7 +50,7 @@ func TestAuthUseCase_Register_WhenValidRequest_ShouldCreateUserAndReturnTokens(t
 		Times(1)
 
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := RegisterRequest{
 		Username: "alice",
@@ -84,7 +86,7 @@ func TestAuthUseCase_Register_WhenUsernameExists_ShouldReturnConflictError(t *te
 
 	mockJWT := mocks.NewMockJWTManager(ctrl)
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := RegisterRequest{
 		Username: "alice",
@@ -103,6 +105,187 @@ func TestAuthUseCase_Register_WhenUsernameExists_ShouldReturnConflictError(t *te
 	require.Contains(t, err.Error(), "username")
 }
 
+func TestAuthUseCase_Register_WhenIdempotentAndSameCredentials_ShouldReturnExistingUserAndTokens(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("secure123"), bcrypt.MinCost)
+	require.NoError(t, err)
+	existing := &domain.User{ID: "user-123", Username: "alice", Email: "alice@example.com", Password: string(hashed)}
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByUsername(gomock.Any(), "alice").
+		Return(existing, nil).
+		Times(1)
+	mockUserRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+	mockJWT.EXPECT().
+		GenerateTokenPair("user-123").
+		Return(&domain.TokenPair{AccessToken: "access-token", RefreshToken: "refresh-token"}, nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{IdempotentRegistration: true}, logger)
+
+	req := RegisterRequest{
+		Username: "alice",
+		Email:    "alice@example.com",
+		Password: "secure123",
+	}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	user, tokenPair, err := uc.Register(ctx, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, existing, user)
+	require.NotNil(t, tokenPair)
+	require.Equal(t, "access-token", tokenPair.AccessToken)
+}
+
+func TestAuthUseCase_Register_WhenIdempotentAndPasswordDiffers_ShouldReturnConflictError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("secure123"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByUsername(gomock.Any(), "alice").
+		Return(&domain.User{ID: "user-123", Username: "alice", Email: "alice@example.com", Password: string(hashed)}, nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{IdempotentRegistration: true}, logger)
+
+	req := RegisterRequest{
+		Username: "alice",
+		Email:    "alice@example.com",
+		Password: "different456",
+	}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	user, tokenPair, err := uc.Register(ctx, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Nil(t, user)
+	require.Nil(t, tokenPair)
+	require.True(t, errors.Is(err, domain.ErrUserAlreadyExists))
+}
+
+func TestAuthUseCase_Register_WhenEmailSubdomainAllowed_ShouldCreateUser(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByUsername(ctx, "alice").
+		Return(nil, nil).
+		Times(1)
+	mockUserRepo.EXPECT().
+		GetByEmail(ctx, "alice@eng.Example.com").
+		Return(nil, nil).
+		Times(1)
+	mockUserRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, user *domain.User) error {
+			user.ID = "user-123"
+			return nil
+		}).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+	mockJWT.EXPECT().
+		GenerateTokenPair("user-123").
+		Return(&domain.TokenPair{AccessToken: "access-token"}, nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	cfg := AuthConfig{AllowedEmailDomains: []string{"corp.io", "*.example.com"}}
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, cfg, logger)
+
+	req := RegisterRequest{
+		Username: "alice",
+		Email:    "alice@eng.Example.com",
+		Password: "secure123",
+	}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	user, tokenPair, err := uc.Register(ctx, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, "user-123", user.ID)
+	require.NotNil(t, tokenPair)
+}
+
+func TestAuthUseCase_Register_WhenEmailDomainNotAllowed_ShouldReturnForbiddenError(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   AuthConfig
+		email string
+	}{
+		{
+			name:  "not in allow list",
+			cfg:   AuthConfig{AllowedEmailDomains: []string{"*.example.com"}},
+			email: "alice@example.com",
+		},
+		{
+			name:  "in deny list",
+			cfg:   AuthConfig{DeniedEmailDomains: []string{"mailinator.com"}},
+			email: "alice@MAILINATOR.com",
+		},
+		{
+			name: "denied subdomain of allowed domain",
+			cfg: AuthConfig{
+				AllowedEmailDomains: []string{"*.example.com"},
+				DeniedEmailDomains:  []string{"*.guest.example.com"},
+			},
+			email: "alice@a.guest.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ── Arrange ────────────────────────────────────────────────────────
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// No repository calls are expected: the domain is rejected up front
+			mockUserRepo := mocks.NewMockUserRepository(ctrl)
+			mockJWT := mocks.NewMockJWTManager(ctrl)
+			logger := logger.New("info", false)
+			uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, tt.cfg, logger)
+
+			req := RegisterRequest{
+				Username: "alice",
+				Email:    tt.email,
+				Password: "secure123",
+			}
+
+			// ── Act ─────────────────────────────────────────────────────────────
+			user, tokenPair, err := uc.Register(ctx, req)
+
+			// ── Assert ──────────────────────────────────────────────────────────
+			require.ErrorIs(t, err, domain.ErrEmailDomainNotAllowed)
+			require.Nil(t, user)
+			require.Nil(t, tokenPair)
+		})
+	}
+}
+
 func TestAuthUseCase_Register_WhenEmailExists_ShouldReturnConflictError(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	ctx := context.Background()
@@ -121,7 +304,7 @@ func TestAuthUseCase_Register_WhenEmailExists_ShouldReturnConflictError(t *testi
 
 	mockJWT := mocks.NewMockJWTManager(ctrl)
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := RegisterRequest{
 		Username: "alice",
@@ -140,6 +323,47 @@ func TestAuthUseCase_Register_WhenEmailExists_ShouldReturnConflictError(t *testi
 	require.Contains(t, err.Error(), "email")
 }
 
+func TestAuthUseCase_Register_WhenCreateHitsUniqueViolation_ShouldReturnConflictError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByUsername(ctx, "alice").
+		Return(nil, nil).
+		Times(1)
+	mockUserRepo.EXPECT().
+		GetByEmail(ctx, "alice@example.com").
+		Return(nil, nil).
+		Times(1)
+	// A concurrent registration won the insert after both existence checks passed
+	mockUserRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		Return(fmt.Errorf("%w: users_username_key", domain.ErrUserAlreadyExists)).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	req := RegisterRequest{
+		Username: "alice",
+		Email:    "alice@example.com",
+		Password: "secure123",
+	}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	user, tokenPair, err := uc.Register(ctx, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Nil(t, user)
+	require.Nil(t, tokenPair)
+	require.True(t, errors.Is(err, domain.ErrUserAlreadyExists))
+}
+
 func TestAuthUseCase_Register_WhenGetByUsernameFails_ShouldReturnInternalError(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	ctx := context.Background()
@@ -154,7 +378,7 @@ func TestAuthUseCase_Register_WhenGetByUsernameFails_ShouldReturnInternalError(t
 
 	mockJWT := mocks.NewMockJWTManager(ctrl)
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := RegisterRequest{
 		Username: "alice",
@@ -195,7 +419,7 @@ func TestAuthUseCase_Register_WhenCreateFails_ShouldReturnInternalError(t *testi
 
 	mockJWT := mocks.NewMockJWTManager(ctrl)
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := RegisterRequest{
 		Username: "alice",
@@ -244,7 +468,7 @@ func TestAuthUseCase_Register_WhenGenerateTokenFails_ShouldReturnInternalError(t
 		Times(1)
 
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := RegisterRequest{
 		Username: "alice",
@@ -294,7 +518,7 @@ func TestAuthUseCase_Login_WhenValidCredentials_ShouldReturnUserAndTokens(t *tes
 		Times(1)
 
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := LoginRequest{
 		Username: "alice",
@@ -326,7 +550,7 @@ func TestAuthUseCase_Login_WhenUserNotFound_ShouldReturnUnauthorizedError(t *tes
 
 	mockJWT := mocks.NewMockJWTManager(ctrl)
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := LoginRequest{
 		Username: "unknown",
@@ -344,6 +568,67 @@ func TestAuthUseCase_Login_WhenUserNotFound_ShouldReturnUnauthorizedError(t *tes
 	require.Contains(t, err.Error(), "invalid credentials")
 }
 
+func TestAuthUseCase_Login_WhenUserNotFound_ShouldStillComparePasswordAgainstDummyHash(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByUsername(ctx, "unknown").
+		Return(nil, nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	var compared [][2]string
+	uc.comparePassword = func(hashedPassword, password []byte) error {
+		compared = append(compared, [2]string{string(hashedPassword), string(password)})
+		return bcrypt.ErrMismatchedHashAndPassword
+	}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, _, err := uc.Login(ctx, LoginRequest{Username: "unknown", Password: "secure123"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	require.Equal(t, [][2]string{{dummyPasswordHash, "secure123"}}, compared)
+
+	cost, err := bcrypt.Cost([]byte(dummyPasswordHash))
+	require.NoError(t, err)
+	require.Equal(t, bcrypt.DefaultCost, cost, "the dummy hash must cost as much as real password hashes")
+}
+
+func TestAuthUseCase_Login_WhenMinLoginDurationSet_ShouldTakeAtLeastThatLong(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByUsername(ctx, "unknown").
+		Return(nil, nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{MinLoginDuration: 50 * time.Millisecond}, logger)
+	uc.comparePassword = func(_, _ []byte) error { return bcrypt.ErrMismatchedHashAndPassword }
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	start := time.Now()
+	_, _, err := uc.Login(ctx, LoginRequest{Username: "unknown", Password: "secure123"})
+	elapsed := time.Since(start)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	require.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+}
+
 func TestAuthUseCase_Login_WhenWrongPassword_ShouldReturnUnauthorizedError(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	ctx := context.Background()
@@ -365,7 +650,7 @@ func TestAuthUseCase_Login_WhenWrongPassword_ShouldReturnUnauthorizedError(t *te
 
 	mockJWT := mocks.NewMockJWTManager(ctrl)
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := LoginRequest{
 		Username: "alice",
@@ -397,7 +682,7 @@ func TestAuthUseCase_Login_WhenGetByUsernameFails_ShouldReturnInternalError(t *t
 
 	mockJWT := mocks.NewMockJWTManager(ctrl)
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	req := LoginRequest{
 		Username: "alice",
@@ -434,7 +719,7 @@ func TestAuthUseCase_ValidateToken_WhenValidToken_ShouldReturnUserID(t *testing.
 		Times(1)
 
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	userID, err := uc.ValidateToken(ctx, "valid-token")
@@ -458,7 +743,7 @@ func TestAuthUseCase_ValidateToken_WhenInvalidToken_ShouldReturnUnauthorizedErro
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	userID, err := uc.ValidateToken(ctx, "invalid-token")
@@ -489,7 +774,7 @@ func TestAuthUseCase_ValidateToken_WhenUserNotFound_ShouldReturnUnauthorizedErro
 		Times(1)
 
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	userID, err := uc.ValidateToken(ctx, "valid-token")
@@ -528,7 +813,7 @@ func TestAuthUseCase_RefreshToken_WhenValidRefreshToken_ShouldReturnNewTokenPair
 		Times(1)
 
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	tokenPair, err := uc.RefreshToken(ctx, "refresh-token")
@@ -554,7 +839,7 @@ func TestAuthUseCase_RefreshToken_WhenInvalidRefreshToken_ShouldReturnUnauthoriz
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	tokenPair, err := uc.RefreshToken(ctx, "invalid-refresh-token")
@@ -586,7 +871,7 @@ func TestAuthUseCase_RefreshToken_WhenUserNotFound_ShouldReturnUnauthorizedError
 		Times(1)
 
 	logger := logger.New("info", false)
-	uc := NewAuthUseCase(mockUserRepo, mockJWT, logger)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	tokenPair, err := uc.RefreshToken(ctx, "refresh-token")
@@ -597,3 +882,426 @@ func TestAuthUseCase_RefreshToken_WhenUserNotFound_ShouldReturnUnauthorizedError
 	require.True(t, errors.Is(err, domain.ErrUserNotFound))
 	require.Contains(t, err.Error(), "user not found")
 }
+
+func TestAuthUseCase_GetPublicProfile_WhenUserExists_ShouldReturnProfile(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetPublicProfile(ctx, "user-123").
+		Return(&domain.PublicUser{ID: "user-123", Username: "alice", CreatedAt: createdAt}, nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	profile, err := uc.GetPublicProfile(ctx, "user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.NotNil(t, profile)
+	require.Equal(t, "alice", profile.Username)
+	require.Equal(t, createdAt, profile.CreatedAt)
+}
+
+func TestAuthUseCase_GetPublicProfile_WhenUserNotFound_ShouldReturnNotFoundError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetPublicProfile(ctx, "user-404").
+		Return(nil, nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	profile, err := uc.GetPublicProfile(ctx, "user-404")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Nil(t, profile)
+	require.True(t, errors.Is(err, domain.ErrUserNotFound))
+}
+
+func TestAuthUseCase_Register_WhenVerificationEnabled_ShouldStoreTokenHashAndSendToken(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().GetByUsername(ctx, "alice").Return(nil, nil).Times(1)
+	mockUserRepo.EXPECT().GetByEmail(ctx, "alice@example.com").Return(nil, nil).Times(1)
+	mockUserRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, user *domain.User) error {
+			user.ID = "user-123"
+			return nil
+		}).
+		Times(1)
+
+	var storedHash string
+	mockUserRepo.EXPECT().
+		SetEmailVerificationToken(ctx, "user-123", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, tokenHash string) error {
+			storedHash = tokenHash
+			return nil
+		}).
+		Times(1)
+
+	var sentToken string
+	mockSender := mocks.NewMockVerificationSender(ctrl)
+	mockSender.EXPECT().
+		SendVerification(ctx, "alice@example.com", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, token string) error {
+			sentToken = token
+			return nil
+		}).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+	mockJWT.EXPECT().
+		GenerateTokenPair("user-123").
+		Return(&domain.TokenPair{AccessToken: "access-token", RefreshToken: "refresh-token", ExpiresIn: 3600}, nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, mockSender, AuthConfig{}, logger)
+
+	req := RegisterRequest{Username: "alice", Email: "alice@example.com", Password: "secure123"}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	user, _, err := uc.Register(ctx, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.False(t, user.EmailVerified)
+	require.NotEmpty(t, sentToken)
+	require.NotEqual(t, sentToken, storedHash) // only the hash is persisted
+	require.Equal(t, hashVerificationToken(sentToken), storedHash)
+}
+
+func TestAuthUseCase_VerifyEmail_WhenTokenMatches_ShouldSucceed(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		VerifyEmailByToken(ctx, hashVerificationToken("verify-token")).
+		Return(true, nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.VerifyEmail(ctx, "verify-token")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestAuthUseCase_VerifyEmail_WhenTokenUnknown_ShouldReturnInvalidVerificationToken(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		VerifyEmailByToken(ctx, gomock.Any()).
+		Return(false, nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.VerifyEmail(ctx, "unknown-token")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.True(t, errors.Is(err, domain.ErrInvalidVerificationToken))
+}
+
+func TestAuthUseCase_IsAdmin_WhenUserHasAdminRole_ShouldReturnTrue(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByID(ctx, "admin-1").
+		Return(&domain.User{ID: "admin-1", Role: domain.RoleAdmin}, nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	isAdmin, err := uc.IsAdmin(ctx, "admin-1")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.True(t, isAdmin)
+}
+
+func TestAuthUseCase_IsAdmin_WhenUserHasUserRole_ShouldReturnFalse(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByID(ctx, "user-1").
+		Return(&domain.User{ID: "user-1", Role: domain.RoleUser}, nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	isAdmin, err := uc.IsAdmin(ctx, "user-1")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.False(t, isAdmin)
+}
+
+func TestAuthUseCase_GetCurrentUser_WhenRepositoryExceedsQueryTimeout_ShouldReturnDeadlineExceeded(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByID(gomock.Any(), "user-123").
+		DoAndReturn(func(ctx context.Context, _ string) (*domain.User, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{QueryTimeout: 10 * time.Millisecond}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	user, err := uc.GetCurrentUser(ctx, "user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Nil(t, user)
+}
+
+func TestAuthUseCase_ListUsers_WhenRepositoryReturnsPage_ShouldReturnUsersAndTotal(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	users := []*domain.User{
+		{ID: "user-3", Username: "carol", Email: "carol@example.com", Role: domain.RoleUser},
+		{ID: "user-4", Username: "dave", Email: "dave@example.com", Role: domain.RoleAdmin},
+	}
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		List(ctx, int64(2), int64(2)).
+		Return(users, int64(5), nil).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	result, total, err := uc.ListUsers(ctx, 2, 2)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, users, result)
+	require.Equal(t, int64(5), total)
+}
+
+func TestAuthUseCase_ListUsers_WhenRepositoryFails_ShouldReturnWrappedError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		List(ctx, int64(10), int64(0)).
+		Return(nil, int64(0), errors.New("database error")).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	result, _, err := uc.ListUsers(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to list users")
+	require.Nil(t, result)
+}
+
+func TestAuthUseCase_ResendEmailVerification_WhenUnverified_ShouldReplaceTokenAndSendIt(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Registered before verification was enabled, so no token was ever issued
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByID(gomock.Any(), "user-123").
+		Return(&domain.User{ID: "user-123", Email: "alice@example.com"}, nil).
+		Times(1)
+
+	var storedHash string
+	mockUserRepo.EXPECT().
+		SetEmailVerificationToken(gomock.Any(), "user-123", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, tokenHash string) error {
+			storedHash = tokenHash
+			return nil
+		}).
+		Times(1)
+
+	var sentToken string
+	mockSender := mocks.NewMockVerificationSender(ctrl)
+	mockSender.EXPECT().
+		SendVerification(gomock.Any(), "alice@example.com", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, token string) error {
+			sentToken = token
+			return nil
+		}).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, mockSender, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.ResendEmailVerification(ctx, "user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, hashVerificationToken(sentToken), storedHash)
+}
+
+func TestAuthUseCase_ResendEmailVerification_WhenAlreadyVerified_ShouldReturnErrEmailAlreadyVerified(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByID(gomock.Any(), "user-123").
+		Return(&domain.User{ID: "user-123", Email: "alice@example.com", EmailVerified: true}, nil).
+		Times(1)
+	mockUserRepo.EXPECT().SetEmailVerificationToken(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockSender := mocks.NewMockVerificationSender(ctrl)
+	mockSender.EXPECT().SendVerification(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, mockSender, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.ResendEmailVerification(ctx, "user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrEmailAlreadyVerified)
+}
+
+func TestAuthUseCase_ResendEmailVerification_WhenSendFails_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByID(gomock.Any(), "user-123").
+		Return(&domain.User{ID: "user-123", Email: "alice@example.com"}, nil).
+		Times(1)
+	mockUserRepo.EXPECT().
+		SetEmailVerificationToken(gomock.Any(), "user-123", gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	mockSender := mocks.NewMockVerificationSender(ctrl)
+	mockSender.EXPECT().
+		SendVerification(gomock.Any(), "alice@example.com", gomock.Any()).
+		Return(errors.New("smtp unavailable")).
+		Times(1)
+
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, mockSender, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.ResendEmailVerification(ctx, "user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to send email verification")
+}
+
+func TestAuthUseCase_ResendEmailVerification_WhenVerificationDisabled_ShouldReturnErrEmailVerificationOff(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Times(0)
+	mockJWT := mocks.NewMockJWTManager(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewAuthUseCase(mockUserRepo, mockJWT, nil, AuthConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.ResendEmailVerification(ctx, "user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrEmailVerificationOff)
+}
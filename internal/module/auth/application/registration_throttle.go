@@ -0,0 +1,12 @@
+package application
+
+import (
+	"context"
+	"time"
+)
+
+// RegistrationThrottleRepository counts registrations per client IP so one address cannot mass-create accounts
+type RegistrationThrottleRepository interface {
+	// Increment bumps ip's count for the window ending at windowEnd and returns the new count
+	Increment(ctx context.Context, ip string, windowEnd time.Time) (int64, error)
+}
@@ -16,6 +16,13 @@ type UserRepository interface {
 	GetByID(ctx context.Context, id string) (*domain.User, error)
 	GetByUsername(ctx context.Context, username string) (*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	// GetPublicProfile returns the public fields of a user, or nil if the user does not exist
+	GetPublicProfile(ctx context.Context, id string) (*domain.PublicUser, error)
+	// List returns users ordered by registration time with the total count; passwords are never loaded
+	List(ctx context.Context, limit, offset int64) ([]*domain.User, int64, error)
 	Update(ctx context.Context, user *domain.User) error
+	SetEmailVerificationToken(ctx context.Context, userID, tokenHash string) error
+	// VerifyEmailByToken marks the user owning tokenHash as verified and reports whether a user matched
+	VerifyEmailByToken(ctx context.Context, tokenHash string) (bool, error)
 	Delete(ctx context.Context, id string) error
 }
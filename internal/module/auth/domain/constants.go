@@ -0,0 +1,7 @@
+package domain
+
+const (
+	// RedisRegistrationCountKeyPrefix prefixes the Redis counters of registrations per client IP and throttle window
+	// (auth:registrations:<window end unix>:<ip>), which expire when their window ends.
+	RedisRegistrationCountKeyPrefix = "auth:registrations:"
+)
@@ -4,8 +4,12 @@ import "errors"
 
 // Domain errors for auth module
 var (
-	ErrUserNotFound      = errors.New("user not found")
-	ErrUserAlreadyExists = errors.New("user already exists")
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrInvalidToken      = errors.New("invalid or expired token")
+	ErrUserNotFound             = errors.New("user not found")
+	ErrUserAlreadyExists        = errors.New("user already exists")
+	ErrInvalidCredentials       = errors.New("invalid credentials")
+	ErrInvalidToken             = errors.New("invalid or expired token")
+	ErrInvalidVerificationToken = errors.New("invalid email verification token")
+	ErrEmailDomainNotAllowed    = errors.New("email domain not allowed")
+	ErrEmailAlreadyVerified     = errors.New("email already verified")
+	ErrEmailVerificationOff     = errors.New("email verification not enabled")
 )
@@ -1,10 +1,27 @@
 // Package domain provides domain entities for the auth module.
 package domain
 
+import "time"
+
 // User represents a user entity (pure business concept)
 type User struct {
-	ID       string `json:"id"`        // User identifier (used for business logic like JWT tokens)
-	Username string `json:"username"`   // User's username
-	Email    string `json:"email"`      // User's email address
-	Password string `json:"-"`          // Never serialize password
+	ID            string `json:"id"`             // User identifier (used for business logic like JWT tokens)
+	Username      string `json:"username"`       // User's username
+	Email         string `json:"email"`          // User's email address
+	Password      string `json:"-"`              // Never serialize password
+	EmailVerified bool   `json:"email_verified"` // Whether the user confirmed their email address
+	Role          string `json:"role"`           // RoleUser or RoleAdmin
+}
+
+// User roles
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// PublicUser is the non-sensitive subset of a user that may be shown to anyone
+type PublicUser struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
 }
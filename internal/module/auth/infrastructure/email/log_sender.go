@@ -0,0 +1,27 @@
+// Package email provides email delivery implementations for the auth module.
+package email
+
+import (
+	"context"
+
+	"real-time-leaderboard/internal/module/auth/application"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// LogVerificationSender implements VerificationSender by logging the token at debug level,
+// so it never reaches logs at the default info level.
+// It is intended for development until a real mail provider is configured.
+type LogVerificationSender struct {
+	logger *logger.Logger
+}
+
+// NewLogVerificationSender creates a new logging verification sender
+func NewLogVerificationSender(l *logger.Logger) application.VerificationSender {
+	return &LogVerificationSender{logger: l}
+}
+
+// SendVerification logs the verification token for the given email address
+func (s *LogVerificationSender) SendVerification(ctx context.Context, email, token string) error {
+	s.logger.Debugf(ctx, "Email verification token for %s: %s", email, token)
+	return nil
+}
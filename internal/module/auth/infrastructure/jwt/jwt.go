@@ -12,9 +12,19 @@ import (
 
 // Manager handles JWT token operations
 type Manager struct {
-	secretKey     string
-	accessExpiry  time.Duration
-	refreshExpiry time.Duration
+	signingKey Key
+	// verificationKeys maps each accepted kid header to its secret, including the signing key's
+	verificationKeys map[string]string
+	accessExpiry     time.Duration
+	refreshExpiry    time.Duration
+	leeway           time.Duration
+}
+
+// Key is an HMAC secret together with the kid header of the tokens it signs.
+// An empty ID matches tokens without a kid header, such as those issued before keys had IDs.
+type Key struct {
+	ID     string
+	Secret string
 }
 
 // Claims represents JWT claims
@@ -23,12 +33,24 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-// NewManager creates a new JWT manager
-func NewManager(secretKey string, accessExpiry, refreshExpiry time.Duration) *Manager {
+// NewManager creates a new JWT manager.
+// Tokens are signed with signingKey and validated against it and verificationKeys, so a replaced signing key
+// can stay in verificationKeys until the tokens it signed have expired.
+// leeway is the clock skew tolerated when checking the exp, nbf and iat claims.
+func NewManager(signingKey Key, verificationKeys []Key, accessExpiry, refreshExpiry, leeway time.Duration) *Manager {
+	keys := make(map[string]string, len(verificationKeys)+1)
+	for _, key := range verificationKeys {
+		keys[key.ID] = key.Secret
+	}
+	// The signing key always validates, even when a verification key reuses its ID
+	keys[signingKey.ID] = signingKey.Secret
+
 	return &Manager{
-		secretKey:     secretKey,
-		accessExpiry:  accessExpiry,
-		refreshExpiry: refreshExpiry,
+		signingKey:       signingKey,
+		verificationKeys: keys,
+		accessExpiry:     accessExpiry,
+		refreshExpiry:    refreshExpiry,
+		leeway:           leeway,
 	}
 }
 
@@ -66,7 +88,10 @@ func (m *Manager) generateToken(userID string, expiry time.Duration) (string, ti
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(m.secretKey))
+	if m.signingKey.ID != "" {
+		token.Header["kid"] = m.signingKey.ID
+	}
+	tokenString, err := token.SignedString([]byte(m.signingKey.Secret))
 	if err != nil {
 		return "", 0, err
 	}
@@ -74,14 +99,19 @@ func (m *Manager) generateToken(userID string, expiry time.Duration) (string, ti
 	return tokenString, expiry, nil
 }
 
-// ValidateToken validates a JWT token and returns the user ID
+// ValidateToken validates a JWT token against the key matching its kid header and returns the user ID
 func (m *Manager) ValidateToken(tokenString string) (string, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(m.secretKey), nil
-	})
+		kid, _ := token.Header["kid"].(string)
+		secret, ok := m.verificationKeys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key: %q", kid)
+		}
+		return []byte(secret), nil
+	}, jwt.WithLeeway(m.leeway))
 
 	if err != nil {
 		return "", err
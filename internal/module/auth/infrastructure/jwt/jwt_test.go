@@ -0,0 +1,112 @@
+package jwt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "test-secret"
+
+// signWithNotBefore signs a token for user-123 that only becomes valid after nbf
+func signWithNotBefore(t *testing.T, nbf time.Time) string {
+	t.Helper()
+
+	now := time.Now()
+	claims := &Claims{
+		UserID: "user-123",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(nbf),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestManager_ValidateToken_WhenNotBeforeWithinLeeway_ShouldAccept(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	m := NewManager(Key{Secret: testSecret}, nil, time.Minute, time.Hour, 30*time.Second)
+	token := signWithNotBefore(t, time.Now().Add(5*time.Second))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	userID, err := m.ValidateToken(token)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, "user-123", userID)
+}
+
+func TestManager_ValidateToken_WhenNotBeforeBeyondLeeway_ShouldReject(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	m := NewManager(Key{Secret: testSecret}, nil, time.Minute, time.Hour, 30*time.Second)
+	token := signWithNotBefore(t, time.Now().Add(2*time.Minute))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	userID, err := m.ValidateToken(token)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, jwt.ErrTokenNotValidYet)
+	require.Empty(t, userID)
+}
+
+func TestManager_ValidateToken_WhenSignedWithRetiredKeyStillAccepted_ShouldAccept(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	oldKey := Key{ID: "2024-01", Secret: "old-secret"}
+	oldManager := NewManager(oldKey, nil, time.Minute, time.Hour, 0)
+	pair, err := oldManager.GenerateTokenPair("user-123")
+	require.NoError(t, err)
+
+	rotated := NewManager(Key{ID: "2024-02", Secret: "new-secret"}, []Key{oldKey}, time.Minute, time.Hour, 0)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	userID, err := rotated.ValidateToken(pair.AccessToken)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, "user-123", userID)
+}
+
+func TestManager_ValidateToken_WhenRetiredKeyRemoved_ShouldReject(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	oldManager := NewManager(Key{ID: "2024-01", Secret: "old-secret"}, nil, time.Minute, time.Hour, 0)
+	pair, err := oldManager.GenerateTokenPair("user-123")
+	require.NoError(t, err)
+
+	rotated := NewManager(Key{ID: "2024-02", Secret: "new-secret"}, nil, time.Minute, time.Hour, 0)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	userID, err := rotated.ValidateToken(pair.AccessToken)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, jwt.ErrTokenUnverifiable)
+	require.Empty(t, userID)
+}
+
+func TestManager_GenerateTokenPair_WhenKeyHasID_ShouldSetKidHeaderAndValidate(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	m := NewManager(Key{ID: "2024-02", Secret: "new-secret"}, []Key{{Secret: testSecret}}, time.Minute, time.Hour, 0)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	pair, err := m.GenerateTokenPair("user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	token, _, err := jwt.NewParser().ParseUnverified(pair.AccessToken, &Claims{})
+	require.NoError(t, err)
+	require.Equal(t, "2024-02", token.Header["kid"])
+
+	userID, err := m.ValidateToken(pair.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, "user-123", userID)
+
+	// Tokens issued before keys had IDs still match the verification key without one
+	legacyUserID, err := m.ValidateToken(signWithNotBefore(t, time.Now()))
+	require.NoError(t, err)
+	require.Equal(t, "user-123", legacyUserID)
+}
@@ -114,6 +114,51 @@ func (mr *MockUserRepositoryMockRecorder) GetByUsername(ctx, username any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockUserRepository)(nil).GetByUsername), ctx, username)
 }
 
+// GetPublicProfile mocks base method.
+func (m *MockUserRepository) GetPublicProfile(ctx context.Context, id string) (*domain.PublicUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicProfile", ctx, id)
+	ret0, _ := ret[0].(*domain.PublicUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicProfile indicates an expected call of GetPublicProfile.
+func (mr *MockUserRepositoryMockRecorder) GetPublicProfile(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicProfile", reflect.TypeOf((*MockUserRepository)(nil).GetPublicProfile), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockUserRepository) List(ctx context.Context, limit, offset int64) ([]*domain.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserRepositoryMockRecorder) List(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), ctx, limit, offset)
+}
+
+// SetEmailVerificationToken mocks base method.
+func (m *MockUserRepository) SetEmailVerificationToken(ctx context.Context, userID, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmailVerificationToken", ctx, userID, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmailVerificationToken indicates an expected call of SetEmailVerificationToken.
+func (mr *MockUserRepositoryMockRecorder) SetEmailVerificationToken(ctx, userID, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailVerificationToken", reflect.TypeOf((*MockUserRepository)(nil).SetEmailVerificationToken), ctx, userID, tokenHash)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) error {
 	m.ctrl.T.Helper()
@@ -127,3 +172,18 @@ func (mr *MockUserRepositoryMockRecorder) Update(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), ctx, user)
 }
+
+// VerifyEmailByToken mocks base method.
+func (m *MockUserRepository) VerifyEmailByToken(ctx context.Context, tokenHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmailByToken", ctx, tokenHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmailByToken indicates an expected call of VerifyEmailByToken.
+func (mr *MockUserRepositoryMockRecorder) VerifyEmailByToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmailByToken", reflect.TypeOf((*MockUserRepository)(nil).VerifyEmailByToken), ctx, tokenHash)
+}
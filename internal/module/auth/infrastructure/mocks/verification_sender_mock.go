@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: real-time-leaderboard/internal/module/auth/application (interfaces: VerificationSender)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../infrastructure/mocks/verification_sender_mock.go -package=mocks real-time-leaderboard/internal/module/auth/application VerificationSender
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVerificationSender is a mock of VerificationSender interface.
+type MockVerificationSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerificationSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockVerificationSenderMockRecorder is the mock recorder for MockVerificationSender.
+type MockVerificationSenderMockRecorder struct {
+	mock *MockVerificationSender
+}
+
+// NewMockVerificationSender creates a new mock instance.
+func NewMockVerificationSender(ctrl *gomock.Controller) *MockVerificationSender {
+	mock := &MockVerificationSender{ctrl: ctrl}
+	mock.recorder = &MockVerificationSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerificationSender) EXPECT() *MockVerificationSenderMockRecorder {
+	return m.recorder
+}
+
+// SendVerification mocks base method.
+func (m *MockVerificationSender) SendVerification(ctx context.Context, email, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerification", ctx, email, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendVerification indicates an expected call of SendVerification.
+func (mr *MockVerificationSenderMockRecorder) SendVerification(ctx, email, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerification", reflect.TypeOf((*MockVerificationSender)(nil).SendVerification), ctx, email, token)
+}
@@ -3,17 +3,23 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"real-time-leaderboard/internal/module/auth/application"
 	"real-time-leaderboard/internal/module/auth/domain"
+	"real-time-leaderboard/internal/shared/database"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// pgUniqueViolation is the PostgreSQL SQLSTATE for a unique constraint violation
+const pgUniqueViolation = "23505"
+
 // PostgresUserRepository implements UserRepository using PostgreSQL
 type PostgresUserRepository struct {
 	pool *pgxpool.Pool
@@ -31,54 +37,72 @@ func (r *PostgresUserRepository) Create(ctx context.Context, user *domain.User)
 		Username: user.Username,
 		Email:    user.Email,
 		Password: user.Password,
+		Role:     user.Role,
 	}
 
 	if dto.ID == "" {
 		dto.ID = uuid.New().String()
 	}
+	if dto.Role == "" {
+		dto.Role = domain.RoleUser
+	}
 	now := time.Now()
 	// Timestamps are infrastructure concerns, handled in DTO only
 	dto.CreatedAt = now
 	dto.UpdatedAt = now
 
 	query := `
-		INSERT INTO users (id, username, email, password_hash, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO users (id, username, email, password_hash, role, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	_, err := r.pool.Exec(database.WithQueryName(ctx, "CreateUser"), query,
 		dto.ID,
 		dto.Username,
 		dto.Email,
 		dto.Password,
+		dto.Role,
 		dto.CreatedAt,
 		dto.UpdatedAt,
 	)
 
 	if err != nil {
-		return fmt.Errorf("failed to create user: %w", err)
+		return mapCreateError(err)
 	}
 
-	// Update domain entity with generated ID only (timestamps stay in infrastructure)
+	// Update domain entity with generated ID and default role only (timestamps stay in infrastructure)
 	user.ID = dto.ID
+	user.Role = dto.Role
 
 	return nil
 }
 
+// mapCreateError reports a unique violation as ErrUserAlreadyExists, which happens when a concurrent
+// registration inserts the same username or email between the existence check and the insert
+func mapCreateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return fmt.Errorf("%w: %s", domain.ErrUserAlreadyExists, pgErr.ConstraintName)
+	}
+	return fmt.Errorf("failed to create user: %w", err)
+}
+
 // GetByID retrieves a user by ID
 func (r *PostgresUserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
 	query := `
-		SELECT id, username, email, password_hash, created_at, updated_at
+		SELECT id, username, email, password_hash, email_verified, role, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
 
 	var dto User
-	err := r.pool.QueryRow(ctx, query, id).Scan(
+	err := r.pool.QueryRow(database.WithQueryName(ctx, "GetUserByID"), query, id).Scan(
 		&dto.ID,
 		&dto.Username,
 		&dto.Email,
 		&dto.Password,
+		&dto.EmailVerified,
+		&dto.Role,
 		&dto.CreatedAt,
 		&dto.UpdatedAt,
 	)
@@ -91,10 +115,12 @@ func (r *PostgresUserRepository) GetByID(ctx context.Context, id string) (*domai
 	}
 
 	return &domain.User{
-		ID:       dto.ID,
-		Username: dto.Username,
-		Email:    dto.Email,
-		Password: dto.Password,
+		ID:            dto.ID,
+		Username:      dto.Username,
+		Email:         dto.Email,
+		Password:      dto.Password,
+		EmailVerified: dto.EmailVerified,
+		Role:          dto.Role,
 		// Timestamps are infrastructure concerns, not part of domain entity
 	}, nil
 }
@@ -102,17 +128,19 @@ func (r *PostgresUserRepository) GetByID(ctx context.Context, id string) (*domai
 // GetByUsername retrieves a user by username
 func (r *PostgresUserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
 	query := `
-		SELECT id, username, email, password_hash, created_at, updated_at
+		SELECT id, username, email, password_hash, email_verified, role, created_at, updated_at
 		FROM users
 		WHERE username = $1
 	`
 
 	var dto User
-	err := r.pool.QueryRow(ctx, query, username).Scan(
+	err := r.pool.QueryRow(database.WithQueryName(ctx, "GetUserByUsername"), query, username).Scan(
 		&dto.ID,
 		&dto.Username,
 		&dto.Email,
 		&dto.Password,
+		&dto.EmailVerified,
+		&dto.Role,
 		&dto.CreatedAt,
 		&dto.UpdatedAt,
 	)
@@ -125,28 +153,100 @@ func (r *PostgresUserRepository) GetByUsername(ctx context.Context, username str
 	}
 
 	return &domain.User{
-		ID:       dto.ID,
-		Username: dto.Username,
-		Email:    dto.Email,
-		Password: dto.Password,
+		ID:            dto.ID,
+		Username:      dto.Username,
+		Email:         dto.Email,
+		Password:      dto.Password,
+		EmailVerified: dto.EmailVerified,
+		Role:          dto.Role,
 		// Timestamps are infrastructure concerns, not part of domain entity
 	}, nil
 }
 
+// GetPublicProfile retrieves the public fields of a user by ID
+func (r *PostgresUserRepository) GetPublicProfile(ctx context.Context, id string) (*domain.PublicUser, error) {
+	query := `
+		SELECT id, username, created_at
+		FROM users
+		WHERE id = $1
+	`
+
+	var dto User
+	err := r.pool.QueryRow(database.WithQueryName(ctx, "GetPublicProfile"), query, id).Scan(
+		&dto.ID,
+		&dto.Username,
+		&dto.CreatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get public profile: %w", err)
+	}
+
+	return &domain.PublicUser{
+		ID:        dto.ID,
+		Username:  dto.Username,
+		CreatedAt: dto.CreatedAt,
+	}, nil
+}
+
+// List retrieves a page of users, oldest first, and the total user count.
+// The password hash is not selected, so listed users always have an empty Password.
+func (r *PostgresUserRepository) List(ctx context.Context, limit, offset int64) ([]*domain.User, int64, error) {
+	query := `
+		SELECT id, username, email, email_verified, role, COUNT(*) OVER() AS total
+		FROM users
+		ORDER BY created_at, id
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.pool.Query(database.WithQueryName(ctx, "ListUsers"), query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	users := []*domain.User{}
+	var total int64
+	for rows.Next() {
+		var dto User
+		if err := rows.Scan(&dto.ID, &dto.Username, &dto.Email, &dto.EmailVerified, &dto.Role, &total); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, &domain.User{
+			ID:            dto.ID,
+			Username:      dto.Username,
+			Email:         dto.Email,
+			EmailVerified: dto.EmailVerified,
+			Role:          dto.Role,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating users: %w", err)
+	}
+
+	return users, total, nil
+}
+
 // GetByEmail retrieves a user by email
 func (r *PostgresUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	query := `
-		SELECT id, username, email, password_hash, created_at, updated_at
+		SELECT id, username, email, password_hash, email_verified, role, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
 
 	var dto User
-	err := r.pool.QueryRow(ctx, query, email).Scan(
+	err := r.pool.QueryRow(database.WithQueryName(ctx, "GetUserByEmail"), query, email).Scan(
 		&dto.ID,
 		&dto.Username,
 		&dto.Email,
 		&dto.Password,
+		&dto.EmailVerified,
+		&dto.Role,
 		&dto.CreatedAt,
 		&dto.UpdatedAt,
 	)
@@ -159,10 +259,12 @@ func (r *PostgresUserRepository) GetByEmail(ctx context.Context, email string) (
 	}
 
 	return &domain.User{
-		ID:       dto.ID,
-		Username: dto.Username,
-		Email:    dto.Email,
-		Password: dto.Password,
+		ID:            dto.ID,
+		Username:      dto.Username,
+		Email:         dto.Email,
+		Password:      dto.Password,
+		EmailVerified: dto.EmailVerified,
+		Role:          dto.Role,
 		// Timestamps are infrastructure concerns, not part of domain entity
 	}, nil
 }
@@ -184,7 +286,7 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *domain.User)
 		WHERE id = $1
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	_, err := r.pool.Exec(database.WithQueryName(ctx, "UpdateUser"), query,
 		dto.ID,
 		dto.Username,
 		dto.Email,
@@ -201,11 +303,43 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *domain.User)
 	return nil
 }
 
+// SetEmailVerificationToken stores the hash of a pending email verification token for a user
+func (r *PostgresUserRepository) SetEmailVerificationToken(ctx context.Context, userID, tokenHash string) error {
+	query := `
+		UPDATE users
+		SET email_verification_token_hash = $2, updated_at = $3
+		WHERE id = $1
+	`
+
+	_, err := r.pool.Exec(database.WithQueryName(ctx, "SetEmailVerificationToken"), query, userID, tokenHash, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to set email verification token: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyEmailByToken marks the user owning the token hash as verified and consumes the token
+func (r *PostgresUserRepository) VerifyEmailByToken(ctx context.Context, tokenHash string) (bool, error) {
+	query := `
+		UPDATE users
+		SET email_verified = TRUE, email_verification_token_hash = NULL, updated_at = $2
+		WHERE email_verification_token_hash = $1
+	`
+
+	tag, err := r.pool.Exec(database.WithQueryName(ctx, "VerifyEmailByToken"), query, tokenHash, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("failed to verify email: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
 // Delete deletes a user
 func (r *PostgresUserRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM users WHERE id = $1`
 
-	_, err := r.pool.Exec(ctx, query, id)
+	_, err := r.pool.Exec(database.WithQueryName(ctx, "DeleteUser"), query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/module/auth/domain"
+)
+
+func TestMapCreateError_WhenUniqueViolation_ShouldReturnUserAlreadyExists(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	pgErr := &pgconn.PgError{Code: "23505", ConstraintName: "users_username_key"}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := mapCreateError(pgErr)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.True(t, errors.Is(err, domain.ErrUserAlreadyExists))
+	require.Contains(t, err.Error(), "users_username_key")
+}
+
+func TestMapCreateError_WhenOtherDatabaseError_ShouldWrapAsCreateFailure(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	pgErr := &pgconn.PgError{Code: "23502", ColumnName: "email"}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := mapCreateError(pgErr)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.False(t, errors.Is(err, domain.ErrUserAlreadyExists))
+	require.True(t, errors.As(err, new(*pgconn.PgError)))
+	require.Contains(t, err.Error(), "failed to create user")
+}
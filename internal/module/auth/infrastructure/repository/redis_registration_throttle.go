@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"real-time-leaderboard/internal/module/auth/application"
+	"real-time-leaderboard/internal/module/auth/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisRegistrationThrottleRepository implements RegistrationThrottleRepository with one Redis counter per IP and window
+type RedisRegistrationThrottleRepository struct {
+	client *redis.Client
+}
+
+// NewRedisRegistrationThrottleRepository creates a new Redis registration throttle repository
+func NewRedisRegistrationThrottleRepository(client *redis.Client) application.RegistrationThrottleRepository {
+	return &RedisRegistrationThrottleRepository{client: client}
+}
+
+// Increment bumps ip's counter for the window ending at windowEnd, expiring it when the window ends
+func (r *RedisRegistrationThrottleRepository) Increment(ctx context.Context, ip string, windowEnd time.Time) (int64, error) {
+	key := domain.RedisRegistrationCountKeyPrefix + strconv.FormatInt(windowEnd.Unix(), 10) + ":" + ip
+
+	var incr *redis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireAt(ctx, key, windowEnd)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count registration: %w", err)
+	}
+
+	return incr.Val(), nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/module/auth/domain"
+)
+
+func TestRedisRegistrationThrottleRepository_Increment_WhenSameIPAndWindow_ShouldCountUpAndExpireAtWindowEnd(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	repo := NewRedisRegistrationThrottleRepository(client)
+
+	now := time.Date(2026, 10, 16, 12, 45, 0, 0, time.UTC)
+	mr.SetTime(now)
+	windowEnd := now.Truncate(time.Hour).Add(time.Hour)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	first, err := repo.Increment(ctx, "203.0.113.7", windowEnd)
+	require.NoError(t, err)
+	second, err := repo.Increment(ctx, "203.0.113.7", windowEnd)
+	require.NoError(t, err)
+	otherIP, err := repo.Increment(ctx, "198.51.100.9", windowEnd)
+	require.NoError(t, err)
+	nextWindow, err := repo.Increment(ctx, "203.0.113.7", windowEnd.Add(time.Hour))
+	require.NoError(t, err)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(2), second)
+	require.Equal(t, int64(1), otherIP)
+	require.Equal(t, int64(1), nextWindow)
+	require.Equal(t, 15*time.Minute, mr.TTL(domain.RedisRegistrationCountKeyPrefix+strconv.FormatInt(windowEnd.Unix(), 10)+":203.0.113.7"))
+}
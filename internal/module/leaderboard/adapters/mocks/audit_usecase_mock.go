@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: real-time-leaderboard/internal/module/leaderboard/application (interfaces: AuditUseCase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../adapters/mocks/audit_usecase_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application AuditUseCase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "real-time-leaderboard/internal/module/leaderboard/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditUseCase is a mock of AuditUseCase interface.
+type MockAuditUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditUseCaseMockRecorder is the mock recorder for MockAuditUseCase.
+type MockAuditUseCaseMockRecorder struct {
+	mock *MockAuditUseCase
+}
+
+// NewMockAuditUseCase creates a new mock instance.
+func NewMockAuditUseCase(ctrl *gomock.Controller) *MockAuditUseCase {
+	mock := &MockAuditUseCase{ctrl: ctrl}
+	mock.recorder = &MockAuditUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditUseCase) EXPECT() *MockAuditUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetScoreAudit mocks base method.
+func (m *MockAuditUseCase) GetScoreAudit(ctx context.Context, userID string, limit, offset int64) ([]domain.ScoreAuditEntry, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScoreAudit", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]domain.ScoreAuditEntry)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetScoreAudit indicates an expected call of GetScoreAudit.
+func (mr *MockAuditUseCaseMockRecorder) GetScoreAudit(ctx, userID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScoreAudit", reflect.TypeOf((*MockAuditUseCase)(nil).GetScoreAudit), ctx, userID, limit, offset)
+}
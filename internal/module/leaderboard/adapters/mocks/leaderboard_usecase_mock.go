@@ -13,6 +13,7 @@ import (
 	context "context"
 	domain "real-time-leaderboard/internal/module/leaderboard/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,35 @@ func (m *MockLeaderboardUseCase) EXPECT() *MockLeaderboardUseCaseMockRecorder {
 	return m.recorder
 }
 
+// GetBroadcastStatus mocks base method.
+func (m *MockLeaderboardUseCase) GetBroadcastStatus(ctx context.Context) domain.BroadcastStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBroadcastStatus", ctx)
+	ret0, _ := ret[0].(domain.BroadcastStatus)
+	return ret0
+}
+
+// GetBroadcastStatus indicates an expected call of GetBroadcastStatus.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetBroadcastStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBroadcastStatus", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetBroadcastStatus), ctx)
+}
+
+// GetGapToNext mocks base method.
+func (m *MockLeaderboardUseCase) GetGapToNext(ctx context.Context, userID string) (domain.GapInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGapToNext", ctx, userID)
+	ret0, _ := ret[0].(domain.GapInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGapToNext indicates an expected call of GetGapToNext.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetGapToNext(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGapToNext", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetGapToNext), ctx, userID)
+}
+
 // GetLeaderboard mocks base method.
 func (m *MockLeaderboardUseCase) GetLeaderboard(ctx context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error) {
 	m.ctrl.T.Helper()
@@ -57,17 +87,152 @@ func (mr *MockLeaderboardUseCaseMockRecorder) GetLeaderboard(ctx, limit, offset
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetLeaderboard), ctx, limit, offset)
 }
 
-// SubscribeToEntryUpdates mocks base method.
-func (m *MockLeaderboardUseCase) SubscribeToEntryUpdates(ctx context.Context) (<-chan *domain.LeaderboardEntry, error) {
+// GetScoreHistogram mocks base method.
+func (m *MockLeaderboardUseCase) GetScoreHistogram(ctx context.Context, buckets int) ([]domain.ScoreBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScoreHistogram", ctx, buckets)
+	ret0, _ := ret[0].([]domain.ScoreBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScoreHistogram indicates an expected call of GetScoreHistogram.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetScoreHistogram(ctx, buckets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScoreHistogram", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetScoreHistogram), ctx, buckets)
+}
+
+// GetStanding mocks base method.
+func (m *MockLeaderboardUseCase) GetStanding(ctx context.Context, userID string, radius int64) (domain.Standing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStanding", ctx, userID, radius)
+	ret0, _ := ret[0].(domain.Standing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStanding indicates an expected call of GetStanding.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetStanding(ctx, userID, radius any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStanding", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetStanding), ctx, userID, radius)
+}
+
+// GetTotalPlayers mocks base method.
+func (m *MockLeaderboardUseCase) GetTotalPlayers(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPlayers", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPlayers indicates an expected call of GetTotalPlayers.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetTotalPlayers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPlayers", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetTotalPlayers), ctx)
+}
+
+// GetUpdatedAt mocks base method.
+func (m *MockLeaderboardUseCase) GetUpdatedAt(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdatedAt", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpdatedAt indicates an expected call of GetUpdatedAt.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetUpdatedAt(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdatedAt", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetUpdatedAt), ctx)
+}
+
+// GetUserRank mocks base method.
+func (m *MockLeaderboardUseCase) GetUserRank(ctx context.Context, userID string) (*domain.LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRank", ctx, userID)
+	ret0, _ := ret[0].(*domain.LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRank indicates an expected call of GetUserRank.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetUserRank(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRank", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetUserRank), ctx, userID)
+}
+
+// GetUserRanks mocks base method.
+func (m *MockLeaderboardUseCase) GetUserRanks(ctx context.Context, userIDs []string) ([]domain.UserRankEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRanks", ctx, userIDs)
+	ret0, _ := ret[0].([]domain.UserRankEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRanks indicates an expected call of GetUserRanks.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetUserRanks(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRanks", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetUserRanks), ctx, userIDs)
+}
+
+// GetUsernames mocks base method.
+func (m *MockLeaderboardUseCase) GetUsernames(ctx context.Context, userIDs []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsernames", ctx, userIDs)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsernames indicates an expected call of GetUsernames.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetUsernames(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsernames", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetUsernames), ctx, userIDs)
+}
+
+// GetViewerCount mocks base method.
+func (m *MockLeaderboardUseCase) GetViewerCount(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewerCount", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetViewerCount indicates an expected call of GetViewerCount.
+func (mr *MockLeaderboardUseCaseMockRecorder) GetViewerCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewerCount", reflect.TypeOf((*MockLeaderboardUseCase)(nil).GetViewerCount), ctx)
+}
+
+// SubscribeToStreamUpdates mocks base method.
+func (m *MockLeaderboardUseCase) SubscribeToStreamUpdates(ctx context.Context) (<-chan domain.StreamUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToStreamUpdates", ctx)
+	ret0, _ := ret[0].(<-chan domain.StreamUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToStreamUpdates indicates an expected call of SubscribeToStreamUpdates.
+func (mr *MockLeaderboardUseCaseMockRecorder) SubscribeToStreamUpdates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToStreamUpdates", reflect.TypeOf((*MockLeaderboardUseCase)(nil).SubscribeToStreamUpdates), ctx)
+}
+
+// WaitForVersionChange mocks base method.
+func (m *MockLeaderboardUseCase) WaitForVersionChange(ctx context.Context, since int64, timeout time.Duration) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubscribeToEntryUpdates", ctx)
-	ret0, _ := ret[0].(<-chan *domain.LeaderboardEntry)
+	ret := m.ctrl.Call(m, "WaitForVersionChange", ctx, since, timeout)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SubscribeToEntryUpdates indicates an expected call of SubscribeToEntryUpdates.
-func (mr *MockLeaderboardUseCaseMockRecorder) SubscribeToEntryUpdates(ctx any) *gomock.Call {
+// WaitForVersionChange indicates an expected call of WaitForVersionChange.
+func (mr *MockLeaderboardUseCaseMockRecorder) WaitForVersionChange(ctx, since, timeout any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToEntryUpdates", reflect.TypeOf((*MockLeaderboardUseCase)(nil).SubscribeToEntryUpdates), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForVersionChange", reflect.TypeOf((*MockLeaderboardUseCase)(nil).WaitForVersionChange), ctx, since, timeout)
 }
@@ -41,6 +41,63 @@ func (m *MockScoreUseCase) EXPECT() *MockScoreUseCaseMockRecorder {
 	return m.recorder
 }
 
+// IncrementScore mocks base method.
+func (m *MockScoreUseCase) IncrementScore(ctx context.Context, userID string, delta int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementScore", ctx, userID, delta)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementScore indicates an expected call of IncrementScore.
+func (mr *MockScoreUseCaseMockRecorder) IncrementScore(ctx, userID, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementScore", reflect.TypeOf((*MockScoreUseCase)(nil).IncrementScore), ctx, userID, delta)
+}
+
+// ResetScore mocks base method.
+func (m *MockScoreUseCase) ResetScore(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetScore", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetScore indicates an expected call of ResetScore.
+func (mr *MockScoreUseCaseMockRecorder) ResetScore(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetScore", reflect.TypeOf((*MockScoreUseCase)(nil).ResetScore), ctx, userID)
+}
+
+// SetScore mocks base method.
+func (m *MockScoreUseCase) SetScore(ctx context.Context, userID string, score int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScore", ctx, userID, score)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetScore indicates an expected call of SetScore.
+func (mr *MockScoreUseCaseMockRecorder) SetScore(ctx, userID, score any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScore", reflect.TypeOf((*MockScoreUseCase)(nil).SetScore), ctx, userID, score)
+}
+
+// ShadowRejections mocks base method.
+func (m *MockScoreUseCase) ShadowRejections() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShadowRejections")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// ShadowRejections indicates an expected call of ShadowRejections.
+func (mr *MockScoreUseCaseMockRecorder) ShadowRejections() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShadowRejections", reflect.TypeOf((*MockScoreUseCase)(nil).ShadowRejections))
+}
+
 // SubmitScore mocks base method.
 func (m *MockScoreUseCase) SubmitScore(ctx context.Context, userID string, req application.SubmitScoreRequest) error {
 	m.ctrl.T.Helper()
@@ -54,3 +111,17 @@ func (mr *MockScoreUseCaseMockRecorder) SubmitScore(ctx, userID, req any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitScore", reflect.TypeOf((*MockScoreUseCase)(nil).SubmitScore), ctx, userID, req)
 }
+
+// ValidateScore mocks base method.
+func (m *MockScoreUseCase) ValidateScore(ctx context.Context, req application.SubmitScoreRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateScore", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateScore indicates an expected call of ValidateScore.
+func (mr *MockScoreUseCaseMockRecorder) ValidateScore(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateScore", reflect.TypeOf((*MockScoreUseCase)(nil).ValidateScore), ctx, req)
+}
@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: real-time-leaderboard/internal/module/leaderboard/application (interfaces: SeasonUseCase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../adapters/mocks/season_usecase_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application SeasonUseCase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "real-time-leaderboard/internal/module/leaderboard/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeasonUseCase is a mock of SeasonUseCase interface.
+type MockSeasonUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeasonUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSeasonUseCaseMockRecorder is the mock recorder for MockSeasonUseCase.
+type MockSeasonUseCaseMockRecorder struct {
+	mock *MockSeasonUseCase
+}
+
+// NewMockSeasonUseCase creates a new mock instance.
+func NewMockSeasonUseCase(ctrl *gomock.Controller) *MockSeasonUseCase {
+	mock := &MockSeasonUseCase{ctrl: ctrl}
+	mock.recorder = &MockSeasonUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeasonUseCase) EXPECT() *MockSeasonUseCaseMockRecorder {
+	return m.recorder
+}
+
+// EndSeason mocks base method.
+func (m *MockSeasonUseCase) EndSeason(ctx context.Context) (*domain.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndSeason", ctx)
+	ret0, _ := ret[0].(*domain.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndSeason indicates an expected call of EndSeason.
+func (mr *MockSeasonUseCaseMockRecorder) EndSeason(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndSeason", reflect.TypeOf((*MockSeasonUseCase)(nil).EndSeason), ctx)
+}
+
+// GetBestRankEver mocks base method.
+func (m *MockSeasonUseCase) GetBestRankEver(ctx context.Context, userID string) (*domain.BestRank, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBestRankEver", ctx, userID)
+	ret0, _ := ret[0].(*domain.BestRank)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBestRankEver indicates an expected call of GetBestRankEver.
+func (mr *MockSeasonUseCaseMockRecorder) GetBestRankEver(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBestRankEver", reflect.TypeOf((*MockSeasonUseCase)(nil).GetBestRankEver), ctx, userID)
+}
+
+// GetRankHistory mocks base method.
+func (m *MockSeasonUseCase) GetRankHistory(ctx context.Context, userID string, start, end time.Time) ([]domain.RankPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRankHistory", ctx, userID, start, end)
+	ret0, _ := ret[0].([]domain.RankPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankHistory indicates an expected call of GetRankHistory.
+func (mr *MockSeasonUseCaseMockRecorder) GetRankHistory(ctx, userID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankHistory", reflect.TypeOf((*MockSeasonUseCase)(nil).GetRankHistory), ctx, userID, start, end)
+}
+
+// GetTopMovers mocks base method.
+func (m *MockSeasonUseCase) GetTopMovers(ctx context.Context, since time.Time, limit int) (*domain.TopMovers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopMovers", ctx, since, limit)
+	ret0, _ := ret[0].(*domain.TopMovers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopMovers indicates an expected call of GetTopMovers.
+func (mr *MockSeasonUseCaseMockRecorder) GetTopMovers(ctx, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopMovers", reflect.TypeOf((*MockSeasonUseCase)(nil).GetTopMovers), ctx, since, limit)
+}
+
+// ListSeasons mocks base method.
+func (m *MockSeasonUseCase) ListSeasons(ctx context.Context, limit, offset int64) ([]domain.Season, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeasons", ctx, limit, offset)
+	ret0, _ := ret[0].([]domain.Season)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSeasons indicates an expected call of ListSeasons.
+func (mr *MockSeasonUseCaseMockRecorder) ListSeasons(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeasons", reflect.TypeOf((*MockSeasonUseCase)(nil).ListSeasons), ctx, limit, offset)
+}
+
+// StartSeason mocks base method.
+func (m *MockSeasonUseCase) StartSeason(ctx context.Context, name string) (*domain.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSeason", ctx, name)
+	ret0, _ := ret[0].(*domain.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSeason indicates an expected call of StartSeason.
+func (mr *MockSeasonUseCaseMockRecorder) StartSeason(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSeason", reflect.TypeOf((*MockSeasonUseCase)(nil).StartSeason), ctx, name)
+}
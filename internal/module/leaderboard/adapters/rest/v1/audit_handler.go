@@ -0,0 +1,123 @@
+// Package v1 provides REST API v1 handlers for the leaderboard module.
+package v1
+
+import (
+	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/shared/logger"
+	"real-time-leaderboard/internal/shared/request"
+	"real-time-leaderboard/internal/shared/response"
+	"real-time-leaderboard/internal/shared/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditHandler handles admin HTTP requests for the score submission audit log
+type AuditHandler struct {
+	auditUseCase application.AuditUseCase
+	logger       *logger.Logger
+}
+
+// NewAuditHandler creates a new audit HTTP handler
+func NewAuditHandler(auditUseCase application.AuditUseCase, l *logger.Logger) *AuditHandler {
+	return &AuditHandler{
+		auditUseCase: auditUseCase,
+		logger:       l,
+	}
+}
+
+// auditQuery represents the query parameters of GET /admin/audit
+type auditQuery struct {
+	request.Pagination
+	UserID string `json:"user_id" form:"user_id" validate:"omitempty,uuid"`
+}
+
+// GetScoreAudit handles GET /admin/audit, optionally filtered by user_id
+func (h *AuditHandler) GetScoreAudit(c *gin.Context) {
+	var query auditQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		valErr := &validator.ValidationError{Message: "limit and offset must be integers", Err: err}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := query.Pagination.Validate(request.MaxLimit); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(query); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	entries, total, err := h.auditUseCase.GetScoreAudit(ctx, query.UserID, query.Limit, query.Offset)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.SuccessWithMeta(c, entries, "Score audit retrieved successfully", response.NewPagination(query.Offset, query.Limit, total))
+}
+
+// GetUserScores handles GET /admin/users/:user_id/scores, the score submissions of any user, newest first
+func (h *AuditHandler) GetUserScores(c *gin.Context) {
+	var uri struct {
+		UserID string `uri:"user_id" json:"user_id" validate:"required,uuid"`
+	}
+	if err := c.ShouldBindUri(&uri); err != nil {
+		valErr := validator.Validate(uri)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(uri); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	var pagination request.Pagination
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		valErr := &validator.ValidationError{Message: "limit and offset must be integers", Err: err}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := pagination.Validate(request.MaxLimit); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	entries, total, err := h.auditUseCase.GetScoreAudit(ctx, uri.UserID, pagination.Limit, pagination.Offset)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.SuccessWithMeta(c, entries, "User scores retrieved successfully", response.NewPagination(pagination.Offset, pagination.Limit, total))
+}
+
+// RegisterAdminRoutes registers admin audit routes (auth and admin role required)
+func (h *AuditHandler) RegisterAdminRoutes(router *gin.RouterGroup) {
+	router.GET("/audit", h.GetScoreAudit)
+	router.GET("/users/:user_id/scores", h.GetUserScores)
+}
@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	lbmocks "real-time-leaderboard/internal/module/leaderboard/adapters/mocks"
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/logger"
+	"real-time-leaderboard/internal/shared/middleware"
+	"real-time-leaderboard/internal/shared/response"
+)
+
+func TestAuditHandler_GetScoreAudit_WhenUserIDFilter_ShouldReturn200WithEntries(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := "00000000-0000-0000-0000-000000000001"
+	mockAudit := lbmocks.NewMockAuditUseCase(ctrl)
+	mockAudit.EXPECT().
+		GetScoreAudit(gomock.Any(), userID, int64(20), int64(0)).
+		Return([]domain.ScoreAuditEntry{{ID: "a-1", UserID: userID, Score: 900, Accepted: false, Reason: "email not verified"}}, int64(1), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/audit?user_id="+userID+"&limit=20", nil)
+
+	h := NewAuditHandler(mockAudit, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetScoreAudit(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Success bool                     `json:"success"`
+		Data    []domain.ScoreAuditEntry `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Success)
+	require.Len(t, body.Data, 1)
+	require.False(t, body.Data[0].Accepted)
+}
+
+func TestAuditHandler_GetScoreAudit_WhenUserIDNotUUID_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAudit := lbmocks.NewMockAuditUseCase(ctrl)
+	mockAudit.EXPECT().GetScoreAudit(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/audit?user_id=bogus", nil)
+
+	h := NewAuditHandler(mockAudit, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetScoreAudit(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
+}
+
+// newAdminAuditRouter mounts the admin audit routes behind the real auth and admin middleware.
+// The bearer token is taken as the caller's user ID, and only "admin-1" is an admin.
+func newAdminAuditRouter(h *AuditHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	validateToken := func(_ context.Context, token string) (string, error) { return token, nil }
+	isAdmin := func(_ context.Context, userID string) (bool, error) { return userID == "admin-1", nil }
+	authMiddleware := middleware.NewAuthMiddleware(validateToken, isAdmin, logger.New("info", false))
+
+	router := gin.New()
+	admin := router.Group("/admin")
+	admin.Use(authMiddleware.RequireAuth(), authMiddleware.RequireAdmin())
+	h.RegisterAdminRoutes(admin)
+	return router
+}
+
+func TestAuditHandler_GetUserScores_WhenCallerIsAdmin_ShouldReturn200WithPagedScores(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := "00000000-0000-0000-0000-000000000002"
+	mockAudit := lbmocks.NewMockAuditUseCase(ctrl)
+	mockAudit.EXPECT().
+		GetScoreAudit(gomock.Any(), userID, int64(2), int64(4)).
+		Return([]domain.ScoreAuditEntry{
+			{ID: "a-5", UserID: userID, Score: 700, Accepted: true},
+			{ID: "a-6", UserID: userID, Score: 650, Accepted: true},
+		}, int64(9), nil).
+		Times(1)
+
+	router := newAdminAuditRouter(NewAuditHandler(mockAudit, logger.New("info", false)))
+	req := httptest.NewRequest(http.MethodGet, "/admin/users/"+userID+"/scores?limit=2&offset=4", nil)
+	req.Header.Set("Authorization", "Bearer admin-1")
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data []domain.ScoreAuditEntry `json:"data"`
+		Meta response.Pagination      `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data, 2)
+	require.Equal(t, "a-5", body.Data[0].ID)
+	require.Equal(t, int64(9), body.Meta.Total)
+}
+
+func TestAuditHandler_GetUserScores_WhenCallerIsNotAdmin_ShouldReturn403(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAudit := lbmocks.NewMockAuditUseCase(ctrl)
+	mockAudit.EXPECT().GetScoreAudit(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	router := newAdminAuditRouter(NewAuditHandler(mockAudit, logger.New("info", false)))
+	req := httptest.NewRequest(http.MethodGet, "/admin/users/00000000-0000-0000-0000-000000000002/scores", nil)
+	req.Header.Set("Authorization", "Bearer user-1")
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestAuditHandler_GetUserScores_WhenUserIDNotUUID_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAudit := lbmocks.NewMockAuditUseCase(ctrl)
+	mockAudit.EXPECT().GetScoreAudit(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	router := newAdminAuditRouter(NewAuditHandler(mockAudit, logger.New("info", false)))
+	req := httptest.NewRequest(http.MethodGet, "/admin/users/bogus/scores", nil)
+	req.Header.Set("Authorization", "Bearer admin-1")
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -2,8 +2,11 @@
 package v1
 
 import (
+	"context"
 	"errors"
+	"time"
 
+	"real-time-leaderboard/internal/module/leaderboard/domain"
 	"real-time-leaderboard/internal/shared/response"
 	"real-time-leaderboard/internal/shared/validator"
 )
@@ -22,6 +25,41 @@ func toAPIError(err error) *response.APIError {
 		}
 	}
 
+	// Check for domain errors
+	if errors.Is(err, domain.ErrUserNotInLeaderboard) {
+		return response.NewNotFoundError("Leaderboard entry")
+	}
+	if errors.Is(err, domain.ErrEmailNotVerified) {
+		return response.NewForbiddenError("Email verification required to submit scores")
+	}
+	if errors.Is(err, domain.ErrScoreResetDisabled) {
+		return response.NewForbiddenError("Resetting your score is disabled")
+	}
+	var quotaErr *domain.SubmissionQuotaError
+	if errors.As(err, &quotaErr) {
+		return response.NewTooManyRequestsError("Daily score submission quota exceeded", time.Until(quotaErr.ResetAt))
+	}
+	if errors.Is(err, domain.ErrNoActiveSeason) {
+		return response.NewNotFoundError("Active season")
+	}
+	if errors.Is(err, domain.ErrNoSeasonStandings) {
+		return response.NewNotFoundError("Season standing")
+	}
+	if errors.Is(err, domain.ErrNotEnoughSeasons) {
+		return response.NewNotFoundError("Ended season to compare")
+	}
+	if errors.Is(err, domain.ErrSeasonAlreadyActive) {
+		return response.NewConflictError("A season is already active")
+	}
+	if errors.Is(err, domain.ErrScoreTooHigh) || errors.Is(err, domain.ErrScoreBelowMinimum) || errors.Is(err, domain.ErrZeroScoreNotAllowed) {
+		return response.NewValidationError(err.Error())
+	}
+
+	// Repository calls that outlived the use-case query timeout
+	if errors.Is(err, context.DeadlineExceeded) {
+		return response.NewTimeoutError("Request timed out")
+	}
+
 	// If it's already an APIError, return it as-is
 	if apiErr, ok := err.(*response.APIError); ok {
 		return apiErr
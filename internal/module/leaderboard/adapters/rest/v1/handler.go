@@ -2,8 +2,13 @@
 package v1
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"real-time-leaderboard/internal/module/leaderboard/application"
@@ -18,49 +23,245 @@ import (
 )
 
 const (
-	// Keep-alive interval for SSE connections
-	keepAliveInterval = 15 * time.Second
+	// Default keep-alive interval for SSE connections
+	defaultKeepAliveInterval = 15 * time.Second
+
+	// SSE event name sent right before the server closes a stream that reached its maximum duration
+	reconnectEvent = "reconnect"
+
+	// SSE event name of viewer count updates; unnamed events carry entry updates
+	viewerCountEvent = "viewer_count"
+
+	// Entries loaded per page while exporting, keeping memory bounded on large boards
+	exportPageSize = request.MaxLimit
+
+	// Response header carrying when the board's scores last changed, in RFC 3339 with fractional seconds
+	updatedAtHeader = "X-Leaderboard-Updated-At"
 )
 
 // LeaderboardHandler handles HTTP requests for leaderboards and scores
 type LeaderboardHandler struct {
 	leaderboardUseCase application.LeaderboardUseCase
 	scoreUseCase       application.ScoreUseCase
+	maxStreamDuration  time.Duration
+	pollTimeout        time.Duration
+	maxIdleKeepAlives  int
+	keepAliveInterval  time.Duration
+	freshnessHeader    bool
 	logger             *logger.Logger
 }
 
-// NewLeaderboardHandler creates a new leaderboard HTTP handler
+// NewLeaderboardHandler creates a new leaderboard HTTP handler.
+// maxStreamDuration closes SSE streams after that long so clients reconnect (0 disables).
+// pollTimeout is how long a long-poll request waits for a change before answering 304 (0 answers at once).
+// maxIdleKeepAlives closes SSE streams after that many keep-alives in a row without an update (0 disables).
+// freshnessHeader adds X-Leaderboard-Updated-At to REST board reads, at the cost of one cache read each.
 func NewLeaderboardHandler(
 	leaderboardUseCase application.LeaderboardUseCase,
 	scoreUseCase application.ScoreUseCase,
+	maxStreamDuration time.Duration,
+	pollTimeout time.Duration,
+	maxIdleKeepAlives int,
+	freshnessHeader bool,
 	l *logger.Logger,
 ) *LeaderboardHandler {
 	return &LeaderboardHandler{
 		leaderboardUseCase: leaderboardUseCase,
 		scoreUseCase:       scoreUseCase,
+		maxStreamDuration:  maxStreamDuration,
+		pollTimeout:        pollTimeout,
+		maxIdleKeepAlives:  maxIdleKeepAlives,
+		keepAliveInterval:  defaultKeepAliveInterval,
+		freshnessHeader:    freshnessHeader,
 		logger:             l,
 	}
 }
 
-// GetLeaderboard handles GET /leaderboard with pagination
+// enrichContext returns the request context, marked to skip the username lookup when enrich=false
+// and to add update times when include_timestamps=true. enrich defaults to true and include_timestamps
+// to false; a value that is not a boolean is a validation error.
+func enrichContext(c *gin.Context) (context.Context, error) {
+	ctx := c.Request.Context()
+
+	if raw := c.Query("enrich"); raw != "" {
+		enrich, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, &validator.ValidationError{Message: "enrich must be a boolean", Err: err}
+		}
+		if !enrich {
+			ctx = application.WithoutUsernames(ctx)
+		}
+	}
+
+	if raw := c.Query("include_timestamps"); raw != "" {
+		include, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, &validator.ValidationError{Message: "include_timestamps must be a boolean", Err: err}
+		}
+		if include {
+			ctx = application.WithTimestamps(ctx)
+		}
+	}
+
+	return ctx, nil
+}
+
+// setUpdatedAtHeader sets X-Leaderboard-Updated-At to when the board last changed. It is read before the
+// data it describes, so that data is never older than the header says. Nothing is set when the header is
+// disabled or the board has never changed, and a failed read only drops the header.
+func (h *LeaderboardHandler) setUpdatedAtHeader(c *gin.Context) {
+	if !h.freshnessHeader {
+		return
+	}
+
+	updatedAt, err := h.leaderboardUseCase.GetUpdatedAt(c.Request.Context())
+	if err != nil {
+		h.logger.Warnf(c.Request.Context(), "Failed to get leaderboard update time for %s: %v", updatedAtHeader, err)
+		return
+	}
+	if updatedAt.IsZero() {
+		return
+	}
+
+	c.Header(updatedAtHeader, updatedAt.UTC().Format(time.RFC3339Nano))
+}
+
+// LeaderboardMeta is the pagination metadata of GET /leaderboard, optionally carrying the caller's own entry
+type LeaderboardMeta struct {
+	response.Pagination
+	Self *domain.LeaderboardEntry `json:"self,omitempty"`
+}
+
+// GetLeaderboard handles GET /leaderboard with pagination.
+// With include_self=true on an authenticated request, the caller's entry is added to meta.self
+// when the caller is ranked but not already part of the returned page.
+// With format=human, every returned entry also carries score_display.
+// With enrich=false, entries (and meta.self) are returned without usernames, skipping their lookup.
+// With include_timestamps=true, they also carry updated_at, when their persisted score last changed.
 func (h *LeaderboardHandler) GetLeaderboard(c *gin.Context) {
 	var pagination request.Pagination
 	if err := c.ShouldBindQuery(&pagination); err != nil {
-		valErr := validator.Validate(pagination)
+		valErr := &validator.ValidationError{Message: "limit and offset must be integers", Err: err}
 		apiErr := toAPIError(valErr)
 		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
 		response.Error(c, apiErr)
 		return
 	}
 
-	if err := validator.Validate(pagination); err != nil {
+	if err := pagination.Validate(request.MaxLimit); err != nil {
 		apiErr := toAPIError(err)
 		h.logger.Err(c.Request.Context(), err).Msg("Request error")
 		response.Error(c, apiErr)
 		return
 	}
 
-	ctx := c.Request.Context()
+	format := c.Query("format")
+	if format != "" && format != scoreFormatHuman {
+		valErr := &validator.ValidationError{Message: fmt.Sprintf("format must be %q", scoreFormatHuman)}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx, err := enrichContext(c)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	h.setUpdatedAtHeader(c)
+	normalized := pagination.Normalize()
+	entries, total, err := h.leaderboardUseCase.GetLeaderboard(ctx, normalized.GetLimit(), normalized.GetOffset())
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	meta := LeaderboardMeta{Pagination: response.NewPagination(normalized.GetOffset(), normalized.GetLimit(), total)}
+	if includeSelf, _ := strconv.ParseBool(c.Query("include_self")); includeSelf {
+		meta.Self = h.getSelfEntry(ctx, c, entries)
+	}
+
+	if format == scoreFormatHuman {
+		for i := range entries {
+			entries[i].ScoreDisplay = formatScore(entries[i].Score)
+		}
+		if meta.Self != nil {
+			meta.Self.ScoreDisplay = formatScore(meta.Self.Score)
+		}
+	}
+
+	response.SuccessWithMeta(c, entries, "Leaderboard retrieved successfully", meta)
+}
+
+// PollMeta is the metadata of GET /leaderboard/poll; clients send Version back as since on their next poll
+type PollMeta struct {
+	response.Pagination
+	Version int64 `json:"version"`
+}
+
+// PollLeaderboard handles GET /leaderboard/poll, a long-polling fallback for clients whose proxies break SSE.
+// Without since, or when the board version already differs from it, the page is returned at once with the
+// current version. Otherwise the request waits up to pollTimeout for a change and answers 304 if none came.
+// enrich and include_timestamps work as on GET /leaderboard.
+func (h *LeaderboardHandler) PollLeaderboard(c *gin.Context) {
+	var pagination request.Pagination
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		valErr := &validator.ValidationError{Message: "limit and offset must be integers", Err: err}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := pagination.Validate(request.MaxLimit); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx, err := enrichContext(c)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	// Versions start at 0, so a first poll without since always gets the board
+	since := int64(-1)
+	if raw := c.Query("since"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			valErr := &validator.ValidationError{Message: "since must be an integer", Err: err}
+			apiErr := toAPIError(valErr)
+			h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+			response.Error(c, apiErr)
+			return
+		}
+		since = parsed
+	}
+
+	version, err := h.leaderboardUseCase.WaitForVersionChange(c.Request.Context(), since, h.pollTimeout)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+	if version == since {
+		c.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
+	h.setUpdatedAtHeader(c)
+	// The version is read before the page, so a change in between is returned again on the next poll, never lost
 	normalized := pagination.Normalize()
 	entries, total, err := h.leaderboardUseCase.GetLeaderboard(ctx, normalized.GetLimit(), normalized.GetOffset())
 	if err != nil {
@@ -70,63 +271,382 @@ func (h *LeaderboardHandler) GetLeaderboard(c *gin.Context) {
 		return
 	}
 
-	meta := response.NewPagination(normalized.GetOffset(), normalized.GetLimit(), total)
+	meta := PollMeta{Pagination: response.NewPagination(normalized.GetOffset(), normalized.GetLimit(), total), Version: version}
 	response.SuccessWithMeta(c, entries, "Leaderboard retrieved successfully", meta)
 }
 
-// GetLeaderboardUpdate handles GET /leaderboard/stream via SSE for real-time delta updates
+// getSelfEntry returns the caller's entry when it is not already in entries.
+// Failures are logged and skipped so the leaderboard page is still served.
+func (h *LeaderboardHandler) getSelfEntry(ctx context.Context, c *gin.Context, entries []domain.LeaderboardEntry) *domain.LeaderboardEntry {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return nil
+	}
+
+	for _, entry := range entries {
+		if entry.UserID == userID {
+			return nil
+		}
+	}
+
+	self, err := h.leaderboardUseCase.GetUserRank(ctx, userID)
+	if err != nil {
+		h.logger.Warnf(ctx, "Failed to get caller rank for include_self: %v", err)
+		return nil
+	}
+
+	return self
+}
+
+// GetTotalPlayers handles GET /leaderboard/count
+func (h *LeaderboardHandler) GetTotalPlayers(c *gin.Context) {
+	h.setUpdatedAtHeader(c)
+	total, err := h.leaderboardUseCase.GetTotalPlayers(c.Request.Context())
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, gin.H{"total": total}, "Total players retrieved successfully")
+}
+
+// GetViewerCount handles GET /leaderboard/viewers
+func (h *LeaderboardHandler) GetViewerCount(c *gin.Context) {
+	viewers, err := h.leaderboardUseCase.GetViewerCount(c.Request.Context())
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, gin.H{"viewers": viewers}, "Viewer count retrieved successfully")
+}
+
+// GetBroadcastStatus handles GET /admin/debug/broadcast so alerts can fire when entry broadcasts stall
+func (h *LeaderboardHandler) GetBroadcastStatus(c *gin.Context) {
+	response.Success(c, h.leaderboardUseCase.GetBroadcastStatus(c.Request.Context()), "Broadcast status retrieved successfully")
+}
+
+// GetShadowRejections handles GET /admin/debug/shadow-rejections, reporting how many submissions this instance
+// accepted in shadow mode that the max score bound would have rejected
+func (h *LeaderboardHandler) GetShadowRejections(c *gin.Context) {
+	response.Success(c, gin.H{"shadow_rejections": h.scoreUseCase.ShadowRejections()}, "Shadow rejections retrieved successfully")
+}
+
+// GetUserRanks handles POST /leaderboard/ranks, returning the ranks of the requested users in request order.
+// enrich=false skips usernames as on GET /leaderboard.
+func (h *LeaderboardHandler) GetUserRanks(c *gin.Context) {
+	var req application.GetUserRanksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx, err := enrichContext(c)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	h.setUpdatedAtHeader(c)
+	entries, err := h.leaderboardUseCase.GetUserRanks(ctx, req.UserIDs)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, entries, "User ranks retrieved successfully")
+}
+
+// GetUsernames handles GET /users/names?ids=a,b,c, returning a map of user ID to username for the known IDs
+func (h *LeaderboardHandler) GetUsernames(c *gin.Context) {
+	var req application.GetUsernamesRequest
+	for _, id := range strings.Split(c.Query("ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			req.UserIDs = append(req.UserIDs, id)
+		}
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	usernames, err := h.leaderboardUseCase.GetUsernames(c.Request.Context(), req.UserIDs)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, usernames, "Usernames retrieved successfully")
+}
+
+// GetGapToNext handles GET /leaderboard/users/:user_id/gap, returning the score needed to reach the next rank
+func (h *LeaderboardHandler) GetGapToNext(c *gin.Context) {
+	var req struct {
+		UserID string `uri:"user_id" json:"user_id" validate:"required,uuid"`
+	}
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	h.setUpdatedAtHeader(c)
+	gap, err := h.leaderboardUseCase.GetGapToNext(c.Request.Context(), req.UserID)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, gap, "Score gap retrieved successfully")
+}
+
+// GetScoreHistogram handles GET /leaderboard/histogram, returning the score distribution of the board
+func (h *LeaderboardHandler) GetScoreHistogram(c *gin.Context) {
+	var req application.GetScoreHistogramRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		valErr := &validator.ValidationError{Message: "buckets must be an integer", Err: err}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	h.setUpdatedAtHeader(c)
+	histogram, err := h.leaderboardUseCase.GetScoreHistogram(c.Request.Context(), req.Buckets)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, histogram, "Score histogram retrieved successfully")
+}
+
+// GetMyStanding handles GET /leaderboard/me, returning the caller's entry with the radius players above and
+// below it in one call. A caller not on the board gets in_leaderboard=false and no entries.
+func (h *LeaderboardHandler) GetMyStanding(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		// Auth middleware already validated the request; missing user_id indicates a server-side bug.
+		apiErr := response.NewInternalError("An unexpected error occurred")
+		h.logger.Error(c.Request.Context(), "user_id missing from context after RequireAuth")
+		response.Error(c, apiErr)
+		return
+	}
+
+	var req application.GetStandingRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		valErr := &validator.ValidationError{Message: "radius must be an integer", Err: err}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	h.setUpdatedAtHeader(c)
+	standing, err := h.leaderboardUseCase.GetStanding(c.Request.Context(), userID, req.Radius)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, standing, "Standing retrieved successfully")
+}
+
+// GetLeaderboardUpdate handles GET /leaderboard/stream via SSE for real-time delta updates.
+// If the subscription cannot be established, a 503 is returned before streaming so clients can fall back to polling.
+// When maxStreamDuration elapses, or maxIdleKeepAlives keep-alives in a row went out without an update,
+// a final "reconnect" event is sent and the stream is closed.
 func (h *LeaderboardHandler) GetLeaderboardUpdate(c *gin.Context) {
+	// The request context is cancelled when the client disconnects, which also tears down the subscription
+	ctx := c.Request.Context()
+
+	// Subscribe to entry delta updates and viewer counts
+	updateCh, err := h.leaderboardUseCase.SubscribeToStreamUpdates(ctx)
+	if err != nil {
+		apiErr := response.NewServiceUnavailableError("Leaderboard updates are temporarily unavailable")
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
 	// Set headers for SSE
 	c.Header("Content-Type", "text/event-stream")
 	c.Header("Cache-Control", "no-cache")
 	c.Header("Connection", "keep-alive")
 	c.Header("X-Accel-Buffering", "no") // Disable nginx buffering
 
-	ctx := c.Request.Context()
-
-	// Subscribe to entry delta updates
-	updateCh, err := h.leaderboardUseCase.SubscribeToEntryUpdates(ctx)
-	if err != nil {
-		// If subscription fails, create a closed channel
-		closedCh := make(chan *domain.LeaderboardEntry)
-		close(closedCh)
-		updateCh = closedCh
-	}
-
 	// Set up keep-alive ticker
-	ticker := time.NewTicker(keepAliveInterval)
+	ticker := time.NewTicker(h.keepAliveInterval)
 	defer ticker.Stop()
+	idleKeepAlives := 0
 
-	// Handle client disconnection
-	notify := c.Writer.CloseNotify()
+	// A nil channel never fires, leaving the stream unbounded
+	var streamDeadline <-chan time.Time
+	if h.maxStreamDuration > 0 {
+		timer := time.NewTimer(h.maxStreamDuration)
+		defer timer.Stop()
+		streamDeadline = timer.C
+	}
 
 	// Keep connection, push delta updates from broadcaster
 	for {
 		select {
-		case <-notify:
+		case <-ctx.Done():
 			// Client disconnected
 			return
 
-		case entry, ok := <-updateCh:
+		case update, ok := <-updateCh:
 			if !ok {
 				// Channel closed, connection ended
 				return
 			}
 
+			// Viewer counts go out as named events so clients listening only for entries are unaffected;
+			// they do not reset the idle count, which tracks leaderboard activity
+			if update.Viewers != nil {
+				resp := response.Response{
+					Success: true,
+					Data:    gin.H{"viewers": *update.Viewers},
+					Message: "Viewer count updated",
+				}
+				messageBytes, _ := json.Marshal(resp)
+				_, _ = fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", viewerCountEvent, messageBytes)
+				c.Writer.Flush()
+				continue
+			}
+
 			// Send entry delta update to client using standard response format
 			resp := response.Response{
 				Success: true,
-				Data:    entry,
+				Data:    update.Entry,
 				Message: "Leaderboard entry updated",
 			}
 			messageBytes, _ := json.Marshal(resp)
 			_, _ = fmt.Fprintf(c.Writer, "data: %s\n\n", messageBytes)
 			c.Writer.Flush()
+			idleKeepAlives = 0
 
 		case <-ticker.C:
+			// Free idle streams; the client reconnects when it still needs updates
+			if h.maxIdleKeepAlives > 0 && idleKeepAlives >= h.maxIdleKeepAlives {
+				writeReconnect(c, "Stream idle, reconnect to continue")
+				return
+			}
+
 			// Send keep-alive comment
 			_, _ = fmt.Fprintf(c.Writer, ": keep-alive\n\n")
 			c.Writer.Flush()
+			idleKeepAlives++
+
+		case <-streamDeadline:
+			writeReconnect(c, "Stream duration limit reached, reconnect to continue")
+			return
+		}
+	}
+}
+
+// writeReconnect sends the final "reconnect" event telling the client to reconnect (and reload its snapshot)
+func writeReconnect(c *gin.Context, message string) {
+	resp := response.Response{
+		Success: true,
+		Message: message,
+	}
+	messageBytes, _ := json.Marshal(resp)
+	_, _ = fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", reconnectEvent, messageBytes)
+	c.Writer.Flush()
+}
+
+// ExportLeaderboard handles GET /admin/leaderboard/export, streaming every entry as newline-delimited JSON.
+// Entries are loaded page by page and flushed as they go. Errors before the first page are returned as JSON;
+// later errors can only be logged, ending the stream early.
+func (h *LeaderboardHandler) ExportLeaderboard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	entries, total, err := h.leaderboardUseCase.GetLeaderboard(ctx, exportPageSize, 0)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Content-Disposition", `attachment; filename="leaderboard.ndjson"`)
+	c.Status(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Writer)
+	var offset int64
+	for {
+		for i := range entries {
+			if err := encoder.Encode(entries[i]); err != nil {
+				h.logger.Warnf(ctx, "Leaderboard export interrupted: %v", err)
+				return
+			}
+		}
+		c.Writer.Flush()
+
+		offset += int64(len(entries))
+		if len(entries) == 0 || offset >= total {
+			return
+		}
+
+		entries, _, err = h.leaderboardUseCase.GetLeaderboard(ctx, exportPageSize, offset)
+		if err != nil {
+			h.logger.Err(ctx, err).Msgf("Leaderboard export stopped after %d entries", offset)
+			return
 		}
 	}
 }
@@ -168,19 +688,183 @@ func (h *LeaderboardHandler) SubmitScore(c *gin.Context) {
 	response.Success(c, gin.H{"user_id": userID, "score": req.Score}, "Score updated successfully")
 }
 
+// IncrementScore handles PATCH /leaderboard/score, adding a delta to the caller's score instead of setting it
+func (h *LeaderboardHandler) IncrementScore(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		// Auth middleware already validated the request; missing user_id indicates a server-side bug.
+		apiErr := response.NewInternalError("An unexpected error occurred")
+		h.logger.Error(c.Request.Context(), "user_id missing from context after RequireAuth")
+		response.Error(c, apiErr)
+		return
+	}
+
+	var req application.IncrementScoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	total, err := h.scoreUseCase.IncrementScore(c.Request.Context(), userID, req.Delta)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, gin.H{"user_id": userID, "score": total}, "Score incremented successfully")
+}
+
+// ResetScore handles DELETE /leaderboard/score, deleting the authenticated user's own score
+func (h *LeaderboardHandler) ResetScore(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		// Auth middleware already validated the request; missing user_id indicates a server-side bug.
+		apiErr := response.NewInternalError("An unexpected error occurred")
+		h.logger.Error(c.Request.Context(), "user_id missing from context after RequireAuth")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := h.scoreUseCase.ResetScore(c.Request.Context(), userID); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, gin.H{"user_id": userID}, "Score reset successfully")
+}
+
+// SetScore handles PUT /admin/scores/:user_id, overwriting a user's score for corrections and testing
+func (h *LeaderboardHandler) SetScore(c *gin.Context) {
+	var uri struct {
+		UserID string `uri:"user_id" json:"user_id" validate:"required,uuid"`
+	}
+
+	if err := c.ShouldBindUri(&uri); err != nil {
+		valErr := validator.Validate(uri)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(uri); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	var req application.SetScoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := h.scoreUseCase.SetScore(c.Request.Context(), uri.UserID, *req.Score); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, gin.H{"user_id": uri.UserID, "score": *req.Score}, "Score set successfully")
+}
+
+// ScoreValidationResult is the outcome of a dry-run score submission
+type ScoreValidationResult struct {
+	Accepted bool   `json:"accepted"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// ValidateScore handles POST /leaderboard/score/validate, a dry run of SubmitScore.
+// The body gets the same request and score checks as a submission; a rejected score is reported
+// in the result with the reason a submission would fail with, and nothing is stored.
+func (h *LeaderboardHandler) ValidateScore(c *gin.Context) {
+	var req application.SubmitScoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	err := validator.Validate(req)
+	if err == nil {
+		err = h.scoreUseCase.ValidateScore(c.Request.Context(), req)
+	}
+	if err == nil {
+		response.Success(c, ScoreValidationResult{Accepted: true}, "Score would be accepted")
+		return
+	}
+
+	apiErr := toAPIError(err)
+	if apiErr.Code != response.CodeValidation {
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, ScoreValidationResult{Accepted: false, Reason: apiErr.Message}, "Score would be rejected")
+}
+
 // RegisterPublicRoutes registers public leaderboard routes (no auth required)
 func (h *LeaderboardHandler) RegisterPublicRoutes(router *gin.RouterGroup) {
 	leaderboard := router.Group("/leaderboard")
 	{
 		leaderboard.GET("", h.GetLeaderboard)
+		leaderboard.GET("/count", h.GetTotalPlayers)
+		leaderboard.POST("/ranks", h.GetUserRanks)
+		leaderboard.GET("/viewers", h.GetViewerCount)
+		leaderboard.GET("/histogram", h.GetScoreHistogram)
+		leaderboard.GET("/users/:user_id/gap", h.GetGapToNext)
 		leaderboard.GET("/stream", h.GetLeaderboardUpdate)
+		leaderboard.GET("/poll", h.PollLeaderboard)
+		leaderboard.POST("/score/validate", h.ValidateScore)
 	}
+	router.GET("/users/names", h.GetUsernames)
 }
 
-// RegisterProtectedRoutes registers protected leaderboard routes (auth required)
-func (h *LeaderboardHandler) RegisterProtectedRoutes(router *gin.RouterGroup) {
+// RegisterProtectedRoutes registers protected leaderboard routes (auth required).
+// submitMiddleware runs before every score write of the caller's own (PUT and PATCH), e.g. to require signed requests.
+func (h *LeaderboardHandler) RegisterProtectedRoutes(router *gin.RouterGroup, submitMiddleware ...gin.HandlerFunc) {
 	leaderboard := router.Group("/leaderboard")
 	{
-		leaderboard.PUT("/score", h.SubmitScore)
+		leaderboard.PUT("/score", append(slices.Clone(submitMiddleware), h.SubmitScore)...)
+		leaderboard.PATCH("/score", append(slices.Clone(submitMiddleware), h.IncrementScore)...)
+		leaderboard.DELETE("/score", h.ResetScore)
+		leaderboard.GET("/me", h.GetMyStanding)
 	}
 }
+
+// RegisterAdminRoutes registers admin leaderboard routes (auth and admin role required)
+func (h *LeaderboardHandler) RegisterAdminRoutes(router *gin.RouterGroup) {
+	router.GET("/leaderboard/export", h.ExportLeaderboard)
+	router.PUT("/scores/:user_id", h.SetScore)
+	router.GET("/debug/broadcast", h.GetBroadcastStatus)
+	router.GET("/debug/shadow-rejections", h.GetShadowRejections)
+}
@@ -3,11 +3,15 @@ package v1
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -17,6 +21,8 @@ import (
 	"real-time-leaderboard/internal/module/leaderboard/domain"
 	lbmocks "real-time-leaderboard/internal/module/leaderboard/adapters/mocks"
 	"real-time-leaderboard/internal/shared/logger"
+	"real-time-leaderboard/internal/shared/middleware"
+	"real-time-leaderboard/internal/shared/request"
 	"real-time-leaderboard/internal/shared/response"
 )
 
@@ -45,7 +51,7 @@ func TestLeaderboardHandler_GetLeaderboard_WhenValidQuery_ShouldReturn200WithEnt
 	c, _ := gin.CreateTestContext(w)
 	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?limit=10&offset=0", nil)
 
-	h := NewLeaderboardHandler(mockLB, mockScore, logger.New("info", false))
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	h.GetLeaderboard(c)
@@ -58,6 +64,7 @@ func TestLeaderboardHandler_GetLeaderboard_WhenValidQuery_ShouldReturn200WithEnt
 	require.Equal(t, "Leaderboard retrieved successfully", body.Message)
 	require.NotNil(t, body.Data)
 	require.NotNil(t, body.Meta)
+	require.NotContains(t, w.Body.String(), "score_display")
 }
 
 func TestLeaderboardHandler_GetLeaderboard_WhenInvalidPagination_ShouldReturn400(t *testing.T) {
@@ -72,9 +79,9 @@ func TestLeaderboardHandler_GetLeaderboard_WhenInvalidPagination_ShouldReturn400
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
-	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?limit=0&offset=0", nil)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?limit=-1&offset=0", nil)
 
-	h := NewLeaderboardHandler(mockLB, mockScore, logger.New("info", false))
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	h.GetLeaderboard(c)
@@ -88,6 +95,312 @@ func TestLeaderboardHandler_GetLeaderboard_WhenInvalidPagination_ShouldReturn400
 	require.Equal(t, string(response.CodeValidation), body.Error.Code)
 }
 
+func TestLeaderboardHandler_GetLeaderboard_WhenPaginationOmitted_ShouldUseDefaults(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), request.DefaultLimit, request.DefaultOffset).
+		Return([]domain.LeaderboardEntry{}, int64(0), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenLimitAboveMax_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().GetLeaderboard(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?limit=101", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenFreshnessHeaderEnabled_ShouldSetParseableUpdatedAt(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updatedAt := time.Date(2026, 10, 16, 12, 30, 15, 250*int(time.Millisecond), time.UTC)
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetUpdatedAt(gomock.Any()).
+		Return(updatedAt, nil).
+		Times(1)
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{{UserID: "user-1", Username: "alice", Score: 1000, Rank: 1}}, int64(1), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, true, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	header := w.Header().Get("X-Leaderboard-Updated-At")
+	require.NotEmpty(t, header)
+	parsed, err := time.Parse(time.RFC3339, header)
+	require.NoError(t, err)
+	require.True(t, updatedAt.Equal(parsed))
+}
+
+func TestLeaderboardHandler_GetTotalPlayers_WhenUpdateTimeUnavailable_ShouldOmitHeaderAndStillServe(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetUpdatedAt(gomock.Any()).
+		Return(time.Time{}, errors.New("redis unavailable")).
+		Times(1)
+	mockLB.EXPECT().
+		GetTotalPlayers(gomock.Any()).
+		Return(int64(42), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/count", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, true, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetTotalPlayers(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, w.Header().Get("X-Leaderboard-Updated-At"))
+}
+
+func TestLeaderboardHandler_GetTotalPlayers_WhenSuccess_ShouldReturn200WithTotal(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetTotalPlayers(gomock.Any()).
+		Return(int64(42), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/count", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetTotalPlayers(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Success bool `json:"success"`
+		Data    struct {
+			Total int64 `json:"total"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Success)
+	require.Equal(t, int64(42), body.Data.Total)
+}
+
+func TestLeaderboardHandler_GetViewerCount_WhenSuccess_ShouldReturn200WithViewers(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetViewerCount(gomock.Any()).
+		Return(int64(7), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/viewers", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetViewerCount(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data struct {
+			Viewers int64 `json:"viewers"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, int64(7), body.Data.Viewers)
+}
+
+func TestLeaderboardHandler_GetShadowRejections_ShouldReturn200WithCount(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().
+		ShadowRejections().
+		Return(int64(3)).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/debug/shadow-rejections", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetShadowRejections(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data struct {
+			ShadowRejections int64 `json:"shadow_rejections"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, int64(3), body.Data.ShadowRejections)
+}
+
+func TestLeaderboardHandler_GetScoreHistogram_WhenSuccess_ShouldReturn200WithBuckets(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetScoreHistogram(gomock.Any(), 2).
+		Return([]domain.ScoreBucket{{Min: 0, Max: 49, Count: 4}, {Min: 50, Max: 99, Count: 1}}, nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/histogram?buckets=2", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetScoreHistogram(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data []domain.ScoreBucket `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, []domain.ScoreBucket{{Min: 0, Max: 49, Count: 4}, {Min: 50, Max: 99, Count: 1}}, body.Data)
+}
+
+func TestLeaderboardHandler_GetScoreHistogram_WhenBucketsAboveMax_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().GetScoreHistogram(gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/histogram?buckets=500", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetScoreHistogram(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
+}
+
+func TestLeaderboardHandler_GetTotalPlayers_WhenQueryTimesOut_ShouldReturn504(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetTotalPlayers(gomock.Any()).
+		Return(int64(0), fmt.Errorf("failed to retrieve total players: %w", context.DeadlineExceeded)).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/count", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetTotalPlayers(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusGatewayTimeout, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeTimeout), body.Error.Code)
+}
+
 func TestLeaderboardHandler_GetLeaderboard_WhenUseCaseReturnsError_ShouldReturn500(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	gin.SetMode(gin.TestMode)
@@ -98,28 +411,1397 @@ func TestLeaderboardHandler_GetLeaderboard_WhenUseCaseReturnsError_ShouldReturn5
 	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
 
 	mockLB.EXPECT().
-		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
-		Return(nil, int64(0), errUseCase).
+		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
+		Return(nil, int64(0), errUseCase).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?limit=10&offset=0", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.False(t, body.Success)
+	require.Equal(t, string(response.CodeInternal), body.Error.Code)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenIncludeSelfAndCallerInPage_ShouldNotDuplicateCaller(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
+		Return(
+			[]domain.LeaderboardEntry{
+				{UserID: "user-1", Username: "alice", Score: 1000, Rank: 1},
+				{UserID: "user-2", Username: "bob", Score: 500, Rank: 2},
+			},
+			int64(2),
+			nil,
+		).
+		Times(1)
+	mockLB.EXPECT().GetUserRank(gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?limit=10&offset=0&include_self=true", nil)
+	c.Set("user_id", "user-2")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data []domain.LeaderboardEntry `json:"data"`
+		Meta LeaderboardMeta           `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data, 2)
+	require.Nil(t, body.Meta.Self)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenIncludeSelfAndCallerOutsidePage_ShouldAppendSelf(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(2), int64(0)).
+		Return(
+			[]domain.LeaderboardEntry{
+				{UserID: "user-1", Username: "alice", Score: 1000, Rank: 1},
+				{UserID: "user-2", Username: "bob", Score: 500, Rank: 2},
+			},
+			int64(50),
+			nil,
+		).
+		Times(1)
+	mockLB.EXPECT().
+		GetUserRank(gomock.Any(), "user-42").
+		Return(&domain.LeaderboardEntry{UserID: "user-42", Username: "zoe", Score: 10, Rank: 42}, nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?limit=2&offset=0&include_self=true", nil)
+	c.Set("user_id", "user-42")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data []domain.LeaderboardEntry `json:"data"`
+		Meta LeaderboardMeta           `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data, 2)
+	require.NotNil(t, body.Meta.Self)
+	require.Equal(t, "user-42", body.Meta.Self.UserID)
+	require.Equal(t, int64(42), body.Meta.Self.Rank)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenFormatHuman_ShouldAddScoreDisplay(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
+		Return(
+			[]domain.LeaderboardEntry{
+				{UserID: "user-1", Username: "alice", Score: 3_400_000, Rank: 1},
+				{UserID: "user-2", Username: "bob", Score: 500, Rank: 2},
+			},
+			int64(2),
+			nil,
+		).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?format=human", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data []domain.LeaderboardEntry `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data, 2)
+	require.Equal(t, int64(3_400_000), body.Data[0].Score)
+	require.Equal(t, "3.4M", body.Data[0].ScoreDisplay)
+	require.Equal(t, "500", body.Data[1].ScoreDisplay)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenFormatUnknown_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().GetLeaderboard(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?format=short", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenEnrichFalse_ShouldSkipUsernames(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
+		DoAndReturn(func(ctx context.Context, _, _ int64) ([]domain.LeaderboardEntry, int64, error) {
+			require.True(t, application.UsernamesSkipped(ctx))
+			return []domain.LeaderboardEntry{{UserID: "user-1", Score: 1000, Rank: 1}}, int64(1), nil
+		}).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?enrich=false", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenEnrichOmitted_ShouldKeepUsernames(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
+		DoAndReturn(func(ctx context.Context, _, _ int64) ([]domain.LeaderboardEntry, int64, error) {
+			require.False(t, application.UsernamesSkipped(ctx))
+			return []domain.LeaderboardEntry{{UserID: "user-1", Username: "alice", Score: 1000, Rank: 1}}, int64(1), nil
+		}).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenEnrichNotBoolean_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().GetLeaderboard(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?enrich=maybe", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "enrich must be a boolean")
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenIncludeTimestamps_ShouldRequestTimestamps(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
+		DoAndReturn(func(ctx context.Context, _, _ int64) ([]domain.LeaderboardEntry, int64, error) {
+			require.True(t, application.TimestampsIncluded(ctx))
+			return []domain.LeaderboardEntry{{UserID: "user-1", Username: "alice", Score: 1000, Rank: 1, UpdatedAt: &updatedAt}}, int64(1), nil
+		}).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?include_timestamps=true", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"updated_at":"2026-03-01T12:00:00Z"`)
+}
+
+func TestLeaderboardHandler_GetLeaderboard_WhenTimestampsNotRequested_ShouldOmitUpdatedAt(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
+		DoAndReturn(func(ctx context.Context, _, _ int64) ([]domain.LeaderboardEntry, int64, error) {
+			require.False(t, application.TimestampsIncluded(ctx))
+			return []domain.LeaderboardEntry{{UserID: "user-1", Username: "alice", Score: 1000, Rank: 1}}, int64(1), nil
+		}).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotContains(t, w.Body.String(), "updated_at")
+}
+
+func TestLeaderboardHandler_GetGapToNext_WhenUserRanked_ShouldReturn200WithGap(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	userID := "00000000-0000-0000-0000-000000000007"
+	mockLB.EXPECT().
+		GetGapToNext(gomock.Any(), userID).
+		Return(domain.GapInfo{UserID: userID, Score: 300, Rank: 7, ScoreGap: 40}, nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/users/"+userID+"/gap", nil)
+	c.Params = gin.Params{{Key: "user_id", Value: userID}}
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetGapToNext(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data domain.GapInfo `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, int64(40), body.Data.ScoreGap)
+	require.False(t, body.Data.IsLeader)
+}
+
+func TestLeaderboardHandler_GetGapToNext_WhenUserNotRanked_ShouldReturn404(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	userID := "00000000-0000-0000-0000-000000000007"
+	mockLB.EXPECT().
+		GetGapToNext(gomock.Any(), userID).
+		Return(domain.GapInfo{}, domain.ErrUserNotInLeaderboard).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/users/"+userID+"/gap", nil)
+	c.Params = gin.Params{{Key: "user_id", Value: userID}}
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetGapToNext(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestLeaderboardHandler_GetMyStanding_WhenCallerRanked_ShouldReturn200WithNeighbors(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetStanding(gomock.Any(), "user-7", int64(1)).
+		Return(domain.Standing{
+			InLeaderboard: true,
+			Self:          &domain.LeaderboardEntry{UserID: "user-7", Username: "grace", Score: 300, Rank: 7},
+			Above:         []domain.LeaderboardEntry{{UserID: "user-6", Username: "frank", Score: 400, Rank: 6}},
+			Below:         []domain.LeaderboardEntry{{UserID: "user-8", Username: "heidi", Score: 200, Rank: 8}},
+		}, nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/me?radius=1", nil)
+	c.Set("user_id", "user-7")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetMyStanding(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data domain.Standing `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Data.InLeaderboard)
+	require.Equal(t, "grace", body.Data.Self.Username)
+	require.Equal(t, "frank", body.Data.Above[0].Username)
+	require.Equal(t, "heidi", body.Data.Below[0].Username)
+}
+
+func TestLeaderboardHandler_GetMyStanding_WhenCallerNotRanked_ShouldReturn200NotInLeaderboard(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetStanding(gomock.Any(), "user-7", int64(0)).
+		Return(domain.Standing{Above: []domain.LeaderboardEntry{}, Below: []domain.LeaderboardEntry{}}, nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/me", nil)
+	c.Set("user_id", "user-7")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetMyStanding(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"in_leaderboard":false`)
+	require.Contains(t, w.Body.String(), `"self":null`)
+	require.Contains(t, w.Body.String(), `"above":[]`)
+}
+
+func TestLeaderboardHandler_GetMyStanding_WhenRadiusTooLarge_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().GetStanding(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/me?radius=26", nil)
+	c.Set("user_id", "user-7")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetMyStanding(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestLeaderboardHandler_SetScore_WhenValid_ShouldSetExactScore(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	userID := "00000000-0000-0000-0000-000000000007"
+	mockScore.EXPECT().
+		SetScore(gomock.Any(), userID, int64(0)).
+		Return(nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/admin/scores/"+userID, strings.NewReader(`{"score":0}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = gin.Params{{Key: "user_id", Value: userID}}
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.SetScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"score":0`)
+}
+
+func TestLeaderboardHandler_SetScore_WhenScoreMissing_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().SetScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	userID := "00000000-0000-0000-0000-000000000007"
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/admin/scores/"+userID, strings.NewReader(`{}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = gin.Params{{Key: "user_id", Value: userID}}
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.SetScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestLeaderboardHandler_ExportLeaderboard_WhenSeveralPages_ShouldStreamOneJSONEntryPerLine(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	board := make([]domain.LeaderboardEntry, 2*exportPageSize+5)
+	for i := range board {
+		board[i] = domain.LeaderboardEntry{UserID: fmt.Sprintf("user-%d", i+1), Score: int64(10_000 - i), Rank: int64(i + 1)}
+	}
+	total := int64(len(board))
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(exportPageSize), gomock.Any()).
+		DoAndReturn(func(_ context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error) {
+			return board[offset:min(offset+limit, total)], total, nil
+		}).
+		Times(3)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/leaderboard/export", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ExportLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+	lines := bytes.Split(bytes.TrimSuffix(w.Body.Bytes(), []byte("\n")), []byte("\n"))
+	require.Len(t, lines, int(total))
+	for i, line := range lines {
+		var entry domain.LeaderboardEntry
+		require.NoError(t, json.Unmarshal(line, &entry), "line %d", i+1)
+		require.Equal(t, board[i], entry)
+	}
+}
+
+func TestLeaderboardHandler_ExportLeaderboard_WhenFirstPageFails_ShouldReturn500(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(exportPageSize), int64(0)).
+		Return(nil, int64(0), errors.New("redis down")).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/leaderboard/export", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ExportLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.NotEqual(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+}
+
+func TestLeaderboardHandler_PollLeaderboard_WhenVersionChanged_ShouldReturn200WithVersion(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().
+		WaitForVersionChange(gomock.Any(), int64(7), time.Second).
+		Return(int64(9), nil).
+		Times(1)
+	mockLB.EXPECT().
+		GetLeaderboard(gomock.Any(), int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{{UserID: "user-1", Username: "alice", Score: 1000, Rank: 1}}, int64(1), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/poll?since=7", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, time.Second, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.PollLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data []domain.LeaderboardEntry `json:"data"`
+		Meta PollMeta                  `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data, 1)
+	require.Equal(t, int64(9), body.Meta.Version)
+}
+
+func TestLeaderboardHandler_PollLeaderboard_WhenNoChangeBeforeTimeout_ShouldReturn304(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+
+	mockLB.EXPECT().
+		WaitForVersionChange(gomock.Any(), int64(7), time.Second).
+		Return(int64(7), nil).
+		Times(1)
+	mockLB.EXPECT().GetLeaderboard(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/poll?since=7", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, time.Second, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.PollLeaderboard(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Empty(t, w.Body.Bytes())
+}
+
+func TestLeaderboardHandler_SubmitScore_WhenUserIDInContextAndValidBody_ShouldReturn200(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	req := application.SubmitScoreRequest{Score: 1500}
+	mockScore.EXPECT().
+		SubmitScore(gomock.Any(), "user-123", req).
+		Return(nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{"score":1500}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("user_id", "user-123")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.SubmitScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Success)
+	require.Equal(t, "Score updated successfully", body.Message)
+}
+
+func TestLeaderboardHandler_SubmitScore_WhenEmailNotVerified_ShouldReturn403(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().
+		SubmitScore(gomock.Any(), "user-123", gomock.Any()).
+		Return(domain.ErrEmailNotVerified).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{"score":1500}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("user_id", "user-123")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.SubmitScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusForbidden, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeForbidden), body.Error.Code)
+}
+
+func TestLeaderboardHandler_SubmitScore_WhenDailyQuotaExceeded_ShouldReturn429WithRetryAfter(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().
+		SubmitScore(gomock.Any(), "user-123", gomock.Any()).
+		Return(&domain.SubmissionQuotaError{Limit: 50, ResetAt: time.Now().Add(time.Hour)}).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{"score":1500}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("user_id", "user-123")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.SubmitScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.NotEmpty(t, w.Header().Get("Retry-After"))
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeTooManyRequests), body.Error.Code)
+}
+
+func TestLeaderboardHandler_SubmitScore_WhenScoreTooHigh_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().
+		SubmitScore(gomock.Any(), "user-123", gomock.Any()).
+		Return(fmt.Errorf("%w: %d", domain.ErrScoreTooHigh, domain.MaxSafeScore)).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{"score":9007199254740993}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("user_id", "user-123")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.SubmitScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
+	require.Contains(t, body.Error.Message, "9007199254740992")
+}
+
+func TestLeaderboardHandler_SubmitScore_WhenUserIDNotInContext_ShouldReturn500(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().SubmitScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{"score":1000}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	// do not set user_id (auth middleware would have set it; this simulates a server-side bug)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.SubmitScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.False(t, body.Success)
+	require.Equal(t, string(response.CodeInternal), body.Error.Code)
+	require.Contains(t, body.Error.Message, "unexpected error")
+}
+
+func TestLeaderboardHandler_SubmitScore_WhenScoreMissingAndZeroNotAllowed_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	// A missing score binds as 0, which the use case rejects unless zero scores are allowed
+	mockScore.EXPECT().
+		SubmitScore(gomock.Any(), "user-123", application.SubmitScoreRequest{Score: 0}).
+		Return(domain.ErrZeroScoreNotAllowed).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("user_id", "user-123")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.SubmitScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.False(t, body.Success)
+	require.NotNil(t, body.Error)
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
+}
+
+func TestLeaderboardHandler_ValidateScore_WhenScoreAccepted_ShouldReturn200Accepted(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().
+		ValidateScore(gomock.Any(), application.SubmitScoreRequest{Score: 1500}).
+		Return(nil).
+		Times(1)
+	mockScore.EXPECT().SubmitScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/leaderboard/score/validate", bytes.NewBufferString(`{"score":1500}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ValidateScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"accepted":true}`, string(mustDataJSON(t, w.Body.Bytes())))
+}
+
+func TestLeaderboardHandler_ValidateScore_WhenScoreTooHigh_ShouldReturn200RejectedWithReason(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().
+		ValidateScore(gomock.Any(), gomock.Any()).
+		Return(fmt.Errorf("%w: %d", domain.ErrScoreTooHigh, 10000)).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/leaderboard/score/validate", bytes.NewBufferString(`{"score":10001}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ValidateScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var result ScoreValidationResult
+	require.NoError(t, json.Unmarshal(mustDataJSON(t, w.Body.Bytes()), &result))
+	require.False(t, result.Accepted)
+	require.Equal(t, "score exceeds the maximum allowed value: 10000", result.Reason)
+}
+
+func TestLeaderboardHandler_ValidateScore_WhenScoreNegative_ShouldRejectWithoutCallingUseCase(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().ValidateScore(gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/leaderboard/score/validate", bytes.NewBufferString(`{"score":-5}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ValidateScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var result ScoreValidationResult
+	require.NoError(t, json.Unmarshal(mustDataJSON(t, w.Body.Bytes()), &result))
+	require.False(t, result.Accepted)
+	require.Equal(t, "score must be greater than or equal to 0", result.Reason)
+}
+
+// mustDataJSON returns the raw data field of a response envelope
+func mustDataJSON(t *testing.T, body []byte) []byte {
+	t.Helper()
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(body, &envelope))
+	return envelope.Data
+}
+
+func TestLeaderboardHandler_SubmitScore_WhenUseCaseReturnsError_ShouldReturn500(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	req := application.SubmitScoreRequest{Score: 1000}
+	mockScore.EXPECT().
+		SubmitScore(gomock.Any(), "user-123", req).
+		Return(errUseCase).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{"score":1000}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("user_id", "user-123")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.SubmitScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.False(t, body.Success)
+	require.Equal(t, string(response.CodeInternal), body.Error.Code)
+}
+
+// errUseCase is a sentinel for use case errors that get mapped to internal API error.
+var errUseCase = errors.New("internal error")
+
+func TestLeaderboardHandler_GetUserRanks_WhenValidRequest_ShouldReturn200InRequestOrder(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ids := []string{
+		"00000000-0000-0000-0000-000000000003",
+		"00000000-0000-0000-0000-000000000404",
+		"00000000-0000-0000-0000-000000000001",
+	}
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetUserRanks(gomock.Any(), ids).
+		Return([]domain.UserRankEntry{
+			{LeaderboardEntry: domain.LeaderboardEntry{UserID: ids[0], Username: "carol", Score: 900, Rank: 3}, InLeaderboard: true},
+			{LeaderboardEntry: domain.LeaderboardEntry{UserID: ids[1], Username: "dave"}, InLeaderboard: false},
+			{LeaderboardEntry: domain.LeaderboardEntry{UserID: ids[2], Username: "alice", Score: 1500, Rank: 1}, InLeaderboard: true},
+		}, nil).
+		Times(1)
+
+	payload, _ := json.Marshal(application.GetUserRanksRequest{UserIDs: ids})
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/leaderboard/ranks", bytes.NewReader(payload))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetUserRanks(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Success bool `json:"success"`
+		Data    []struct {
+			UserID        string `json:"user_id"`
+			Rank          int64  `json:"rank"`
+			InLeaderboard bool   `json:"in_leaderboard"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Success)
+	require.Len(t, body.Data, 3)
+	for i, id := range ids {
+		require.Equal(t, id, body.Data[i].UserID)
+	}
+	require.True(t, body.Data[0].InLeaderboard)
+	require.False(t, body.Data[1].InLeaderboard)
+	require.True(t, body.Data[2].InLeaderboard)
+}
+
+func TestLeaderboardHandler_GetUserRanks_WhenUserIDNotUUID_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().GetUserRanks(gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/leaderboard/ranks", bytes.NewBufferString(`{"user_ids":["bogus"]}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetUserRanks(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
+}
+
+func TestLeaderboardHandler_GetUsernames_WhenSomeUsersUnknown_ShouldReturn200WithKnownUsernames(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	known := "00000000-0000-0000-0000-000000000001"
+	unknown := "00000000-0000-0000-0000-000000000404"
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		GetUsernames(gomock.Any(), []string{known, unknown}).
+		Return(map[string]string{known: "alice"}, nil).
 		Times(1)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
-	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard?limit=10&offset=0", nil)
+	c.Request = httptest.NewRequest(http.MethodGet, "/users/names?ids="+known+",%20"+unknown, nil)
 
-	h := NewLeaderboardHandler(mockLB, mockScore, logger.New("info", false))
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
 
 	// ── Act ─────────────────────────────────────────────────────────────
-	h.GetLeaderboard(c)
+	h.GetUsernames(c)
 
 	// ── Assert ──────────────────────────────────────────────────────────
-	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Success bool              `json:"success"`
+		Data    map[string]string `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Success)
+	require.Equal(t, map[string]string{known: "alice"}, body.Data)
+}
+
+func TestLeaderboardHandler_GetUsernames_WhenTooManyIDs_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().GetUsernames(gomock.Any(), gomock.Any()).Times(0)
+
+	ids := make([]string, 101)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("00000000-0000-0000-0000-%012d", i)
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/users/names?ids="+strings.Join(ids, ","), nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetUsernames(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
+}
+
+func TestLeaderboardHandler_GetLeaderboardUpdate_WhenMaxStreamDurationElapses_ShouldSendReconnectAndReturn(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updates := make(chan domain.StreamUpdate)
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		SubscribeToStreamUpdates(gomock.Any()).
+		Return((<-chan domain.StreamUpdate)(updates), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/stream", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 20*time.Millisecond, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	done := make(chan struct{})
+	go func() {
+		h.GetLeaderboardUpdate(c)
+		close(done)
+	}()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler did not return after the max stream duration")
+	}
+	require.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	require.Contains(t, w.Body.String(), "event: reconnect\ndata: ")
+}
+
+func TestLeaderboardHandler_GetLeaderboardUpdate_WhenIdleKeepAliveCapReached_ShouldSendReconnectAndReturn(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updates := make(chan domain.StreamUpdate)
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		SubscribeToStreamUpdates(gomock.Any()).
+		Return((<-chan domain.StreamUpdate)(updates), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/stream", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 3, false, logger.New("info", false))
+	h.keepAliveInterval = 5 * time.Millisecond
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	done := make(chan struct{})
+	go func() {
+		h.GetLeaderboardUpdate(c)
+		close(done)
+	}()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler did not return after the idle keep-alive cap")
+	}
+	body := w.Body.String()
+	require.Equal(t, 3, strings.Count(body, ": keep-alive\n\n"))
+	require.Contains(t, body, "event: reconnect\ndata: ")
+	require.True(t, strings.HasSuffix(body, "\n\n"))
+}
+
+func TestLeaderboardHandler_GetLeaderboardUpdate_WhenViewerCountArrives_ShouldSendViewerCountEvent(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	viewers := int64(4)
+	updates := make(chan domain.StreamUpdate, 1)
+	updates <- domain.StreamUpdate{Viewers: &viewers}
+	close(updates)
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		SubscribeToStreamUpdates(gomock.Any()).
+		Return((<-chan domain.StreamUpdate)(updates), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/stream", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboardUpdate(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	body := w.Body.String()
+	require.Contains(t, body, "event: viewer_count\ndata: ")
+	require.Contains(t, body, `"data":{"viewers":4}`)
+	require.NotContains(t, body, "Leaderboard entry updated")
+}
+
+func TestLeaderboardHandler_GetLeaderboardUpdate_WhenUpdateArrives_ShouldResetIdleKeepAlives(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updates := make(chan domain.StreamUpdate)
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		SubscribeToStreamUpdates(gomock.Any()).
+		Return((<-chan domain.StreamUpdate)(updates), nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/stream", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 2, false, logger.New("info", false))
+	h.keepAliveInterval = 20 * time.Millisecond
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	done := make(chan struct{})
+	go func() {
+		h.GetLeaderboardUpdate(c)
+		close(done)
+	}()
+	// Updates every ~half interval keep the stream busy, so the cap is never reached while they flow
+	for range 10 {
+		time.Sleep(10 * time.Millisecond)
+		select {
+		case updates <- domain.StreamUpdate{Entry: &domain.LeaderboardEntry{UserID: "user-1", Score: 10, Rank: 1}}:
+		case <-done:
+			t.Fatal("stream closed while updates were flowing")
+		}
+	}
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler did not return after the stream went idle")
+	}
+	body := w.Body.String()
+	require.Equal(t, 10, strings.Count(body, "Leaderboard entry updated"))
+	require.Contains(t, body, "event: reconnect\ndata: ")
+}
+
+func TestLeaderboardHandler_GetLeaderboardUpdate_WhenRequestContextCancelled_ShouldCancelSubscriptionAndReturn(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscribed := make(chan context.Context, 1)
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		SubscribeToStreamUpdates(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) (<-chan domain.StreamUpdate, error) {
+			subscribed <- ctx
+			return make(chan domain.StreamUpdate), nil
+		}).
+		Times(1)
+
+	reqCtx, cancel := context.WithCancel(context.Background())
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/stream", nil).WithContext(reqCtx)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	done := make(chan struct{})
+	go func() {
+		h.GetLeaderboardUpdate(c)
+		close(done)
+	}()
+	subCtx := <-subscribed
+	cancel()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	select {
+	case <-subCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("subscription context was not cancelled with the request")
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler did not return after the request context was cancelled")
+	}
+	require.ErrorIs(t, subCtx.Err(), context.Canceled)
+}
+
+func TestLeaderboardHandler_GetLeaderboardUpdate_WhenSubscriptionFails_ShouldReturn503BeforeStreaming(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockLB.EXPECT().
+		SubscribeToStreamUpdates(gomock.Any()).
+		Return(nil, errors.New("redis: connection refused")).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/leaderboard/stream", nil)
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetLeaderboardUpdate(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.NotEqual(t, "text/event-stream", w.Header().Get("Content-Type"))
 	var body response.Response
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
 	require.False(t, body.Success)
-	require.Equal(t, string(response.CodeInternal), body.Error.Code)
+	require.Equal(t, string(response.CodeServiceUnavailable), body.Error.Code)
 }
 
-func TestLeaderboardHandler_SubmitScore_WhenUserIDInContextAndValidBody_ShouldReturn200(t *testing.T) {
+func TestLeaderboardHandler_IncrementScore_WhenValidDelta_ShouldReturn200WithNewTotal(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	gin.SetMode(gin.TestMode)
 	ctrl := gomock.NewController(t)
@@ -127,32 +1809,34 @@ func TestLeaderboardHandler_SubmitScore_WhenUserIDInContextAndValidBody_ShouldRe
 
 	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
 	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
-	req := application.SubmitScoreRequest{Score: 1500}
 	mockScore.EXPECT().
-		SubmitScore(gomock.Any(), "user-123", req).
-		Return(nil).
+		IncrementScore(gomock.Any(), "user-123", int64(-25)).
+		Return(int64(975), nil).
 		Times(1)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
-	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{"score":1500}`))
+	c.Request = httptest.NewRequest(http.MethodPatch, "/leaderboard/score", bytes.NewBufferString(`{"delta":-25}`))
 	c.Request.Header.Set("Content-Type", "application/json")
 	c.Set("user_id", "user-123")
 
-	h := NewLeaderboardHandler(mockLB, mockScore, logger.New("info", false))
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
 
 	// ── Act ─────────────────────────────────────────────────────────────
-	h.SubmitScore(c)
+	h.IncrementScore(c)
 
 	// ── Assert ──────────────────────────────────────────────────────────
 	require.Equal(t, http.StatusOK, w.Code)
-	var body response.Response
-	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
-	require.True(t, body.Success)
-	require.Equal(t, "Score updated successfully", body.Message)
+	var data struct {
+		UserID string `json:"user_id"`
+		Score  int64  `json:"score"`
+	}
+	require.NoError(t, json.Unmarshal(mustDataJSON(t, w.Body.Bytes()), &data))
+	require.Equal(t, "user-123", data.UserID)
+	require.Equal(t, int64(975), data.Score)
 }
 
-func TestLeaderboardHandler_SubmitScore_WhenUserIDNotInContext_ShouldReturn500(t *testing.T) {
+func TestLeaderboardHandler_IncrementScore_WhenTotalBelowMinimum_ShouldReturn400(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	gin.SetMode(gin.TestMode)
 	ctrl := gomock.NewController(t)
@@ -160,29 +1844,30 @@ func TestLeaderboardHandler_SubmitScore_WhenUserIDNotInContext_ShouldReturn500(t
 
 	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
 	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
-	mockScore.EXPECT().SubmitScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockScore.EXPECT().
+		IncrementScore(gomock.Any(), "user-123", int64(-5000)).
+		Return(int64(0), fmt.Errorf("%w: %d", domain.ErrScoreBelowMinimum, 0)).
+		Times(1)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
-	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{"score":1000}`))
+	c.Request = httptest.NewRequest(http.MethodPatch, "/leaderboard/score", bytes.NewBufferString(`{"delta":-5000}`))
 	c.Request.Header.Set("Content-Type", "application/json")
-	// do not set user_id (auth middleware would have set it; this simulates a server-side bug)
+	c.Set("user_id", "user-123")
 
-	h := NewLeaderboardHandler(mockLB, mockScore, logger.New("info", false))
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
 
 	// ── Act ─────────────────────────────────────────────────────────────
-	h.SubmitScore(c)
+	h.IncrementScore(c)
 
 	// ── Assert ──────────────────────────────────────────────────────────
-	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Equal(t, http.StatusBadRequest, w.Code)
 	var body response.Response
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
-	require.False(t, body.Success)
-	require.Equal(t, string(response.CodeInternal), body.Error.Code)
-	require.Contains(t, body.Error.Message, "unexpected error")
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
 }
 
-func TestLeaderboardHandler_SubmitScore_WhenMissingRequiredFields_ShouldReturn400(t *testing.T) {
+func TestLeaderboardHandler_IncrementScore_WhenDeltaZero_ShouldReturn400WithoutCallingUseCase(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	gin.SetMode(gin.TestMode)
 	ctrl := gomock.NewController(t)
@@ -190,29 +1875,51 @@ func TestLeaderboardHandler_SubmitScore_WhenMissingRequiredFields_ShouldReturn40
 
 	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
 	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
-	mockScore.EXPECT().SubmitScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockScore.EXPECT().IncrementScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
-	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{}`))
+	c.Request = httptest.NewRequest(http.MethodPatch, "/leaderboard/score", bytes.NewBufferString(`{"delta":0}`))
 	c.Request.Header.Set("Content-Type", "application/json")
 	c.Set("user_id", "user-123")
 
-	h := NewLeaderboardHandler(mockLB, mockScore, logger.New("info", false))
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
 
 	// ── Act ─────────────────────────────────────────────────────────────
-	h.SubmitScore(c)
+	h.IncrementScore(c)
 
 	// ── Assert ──────────────────────────────────────────────────────────
 	require.Equal(t, http.StatusBadRequest, w.Code)
-	var body response.Response
-	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
-	require.False(t, body.Success)
-	require.NotNil(t, body.Error)
-	require.Equal(t, string(response.CodeValidation), body.Error.Code)
 }
 
-func TestLeaderboardHandler_SubmitScore_WhenUseCaseReturnsError_ShouldReturn500(t *testing.T) {
+func TestLeaderboardHandler_IncrementScore_WhenSigningEnabledAndUnsigned_ShouldReturn401(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().IncrementScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	reserveNonce := func(context.Context, string, time.Duration) (bool, error) { return true, nil }
+	signature := middleware.NewSignatureMiddleware("test-signing-secret", 5*time.Minute, reserveNonce, logger.New("info", false))
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+	router := gin.New()
+	h.RegisterProtectedRoutes(router.Group(""), signature.RequireSignature())
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/leaderboard/score", bytes.NewBufferString(`{"delta":50}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestLeaderboardHandler_ResetScore_WhenResetDisabled_ShouldReturn403(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	gin.SetMode(gin.TestMode)
 	ctrl := gomock.NewController(t)
@@ -220,30 +1927,52 @@ func TestLeaderboardHandler_SubmitScore_WhenUseCaseReturnsError_ShouldReturn500(
 
 	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
 	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
-	req := application.SubmitScoreRequest{Score: 1000}
 	mockScore.EXPECT().
-		SubmitScore(gomock.Any(), "user-123", req).
-		Return(errUseCase).
+		ResetScore(gomock.Any(), "user-123").
+		Return(domain.ErrScoreResetDisabled).
 		Times(1)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
-	c.Request = httptest.NewRequest(http.MethodPut, "/leaderboard/score", bytes.NewBufferString(`{"score":1000}`))
-	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request = httptest.NewRequest(http.MethodDelete, "/leaderboard/score", nil)
 	c.Set("user_id", "user-123")
 
-	h := NewLeaderboardHandler(mockLB, mockScore, logger.New("info", false))
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
 
 	// ── Act ─────────────────────────────────────────────────────────────
-	h.SubmitScore(c)
+	h.ResetScore(c)
 
 	// ── Assert ──────────────────────────────────────────────────────────
-	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Equal(t, http.StatusForbidden, w.Code)
 	var body response.Response
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
-	require.False(t, body.Success)
-	require.Equal(t, string(response.CodeInternal), body.Error.Code)
+	require.Equal(t, string(response.CodeForbidden), body.Error.Code)
 }
 
-// errUseCase is a sentinel for use case errors that get mapped to internal API error.
-var errUseCase = errors.New("internal error")
+func TestLeaderboardHandler_ResetScore_WhenReset_ShouldReturn200WithUserID(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLB := lbmocks.NewMockLeaderboardUseCase(ctrl)
+	mockScore := lbmocks.NewMockScoreUseCase(ctrl)
+	mockScore.EXPECT().
+		ResetScore(gomock.Any(), "user-123").
+		Return(nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodDelete, "/leaderboard/score", nil)
+	c.Set("user_id", "user-123")
+
+	h := NewLeaderboardHandler(mockLB, mockScore, 0, 0, 0, false, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.ResetScore(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"user_id":"user-123"`)
+}
@@ -0,0 +1,43 @@
+package v1
+
+import "strconv"
+
+// scoreFormatHuman is the format query value that adds an abbreviated score_display to entries
+const scoreFormatHuman = "human"
+
+// scoreUnits are the abbreviation suffixes, largest first
+var scoreUnits = []struct {
+	size   uint64
+	suffix string
+}{
+	{1_000_000_000_000_000, "Q"},
+	{1_000_000_000_000, "T"},
+	{1_000_000_000, "B"},
+	{1_000_000, "M"},
+	{1_000, "K"},
+}
+
+// formatScore abbreviates a score to at most one decimal, e.g. 1250 -> "1.2K" and 3_400_000 -> "3.4M".
+// The decimal is truncated rather than rounded so a score never displays as the next unit up (999_999 -> "999.9K").
+// Scores below 1000 are returned as is.
+func formatScore(score int64) string {
+	sign := ""
+	abs := uint64(score)
+	if score < 0 {
+		sign = "-"
+		abs = uint64(^score) + 1
+	}
+
+	for _, unit := range scoreUnits {
+		if abs < unit.size {
+			continue
+		}
+		whole := abs / unit.size
+		tenth := abs % unit.size * 10 / unit.size
+		if tenth == 0 {
+			return sign + strconv.FormatUint(whole, 10) + unit.suffix
+		}
+		return sign + strconv.FormatUint(whole, 10) + "." + strconv.FormatUint(tenth, 10) + unit.suffix
+	}
+	return strconv.FormatInt(score, 10)
+}
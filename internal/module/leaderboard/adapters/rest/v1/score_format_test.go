@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatScore_AtMagnitudeBoundaries_ShouldAbbreviate(t *testing.T) {
+	cases := []struct {
+		score int64
+		want  string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1_000, "1K"},
+		{1_250, "1.2K"},
+		{999_999, "999.9K"},
+		{1_000_000, "1M"},
+		{3_400_000, "3.4M"},
+		{1_000_000_000, "1B"},
+		{1_000_000_000_000, "1T"},
+		{1_000_000_000_000_000, "1Q"},
+		{math.MaxInt64, "9223.3Q"},
+		{-999, "-999"},
+		{-1_500, "-1.5K"},
+		{math.MinInt64, "-9223.3Q"},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.want, formatScore(tc.score), "score %d", tc.score)
+	}
+}
@@ -0,0 +1,239 @@
+// Package v1 provides REST API v1 handlers for the leaderboard module.
+package v1
+
+import (
+	"time"
+
+	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/shared/logger"
+	"real-time-leaderboard/internal/shared/request"
+	"real-time-leaderboard/internal/shared/response"
+	"real-time-leaderboard/internal/shared/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SeasonHandler handles HTTP requests for leaderboard seasons
+type SeasonHandler struct {
+	seasonUseCase application.SeasonUseCase
+	logger        *logger.Logger
+}
+
+// NewSeasonHandler creates a new season HTTP handler
+func NewSeasonHandler(seasonUseCase application.SeasonUseCase, l *logger.Logger) *SeasonHandler {
+	return &SeasonHandler{
+		seasonUseCase: seasonUseCase,
+		logger:        l,
+	}
+}
+
+// startSeasonRequest represents the body of POST /admin/seasons
+type startSeasonRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+}
+
+// ListSeasons handles GET /seasons
+func (h *SeasonHandler) ListSeasons(c *gin.Context) {
+	var pagination request.Pagination
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		valErr := &validator.ValidationError{Message: "limit and offset must be integers", Err: err}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := pagination.Validate(request.MaxLimit); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	seasons, total, err := h.seasonUseCase.ListSeasons(ctx, pagination.Limit, pagination.Offset)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.SuccessWithMeta(c, seasons, "Seasons retrieved successfully", response.NewPagination(pagination.Offset, pagination.Limit, total))
+}
+
+// StartSeason handles POST /admin/seasons, archiving and resetting the board of the active season first
+func (h *SeasonHandler) StartSeason(c *gin.Context) {
+	var req startSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	season, err := h.seasonUseCase.StartSeason(ctx, req.Name)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Created(c, season, "Season started successfully")
+}
+
+// EndSeason handles POST /admin/seasons/end, archiving the active season's standings and resetting the board
+func (h *SeasonHandler) EndSeason(c *gin.Context) {
+	ctx := c.Request.Context()
+	season, err := h.seasonUseCase.EndSeason(ctx)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, season, "Season ended successfully")
+}
+
+// GetBestRankEver handles GET /seasons/users/:user_id/best-rank
+func (h *SeasonHandler) GetBestRankEver(c *gin.Context) {
+	var req struct {
+		UserID string `uri:"user_id" json:"user_id" validate:"required,uuid"`
+	}
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	best, err := h.seasonUseCase.GetBestRankEver(ctx, req.UserID)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, best, "Best rank retrieved successfully")
+}
+
+// rankHistoryQuery represents the optional RFC 3339 range of GET /seasons/users/:user_id/rank-history
+type rankHistoryQuery struct {
+	Start time.Time `form:"start" time_format:"2006-01-02T15:04:05Z07:00"`
+	End   time.Time `form:"end" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// GetRankHistory handles GET /seasons/users/:user_id/rank-history, returning the user's final rank per ended season
+func (h *SeasonHandler) GetRankHistory(c *gin.Context) {
+	var req struct {
+		UserID string `uri:"user_id" json:"user_id" validate:"required,uuid"`
+	}
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		valErr := validator.Validate(req)
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	var query rankHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		valErr := &validator.ValidationError{Message: "start and end must be RFC 3339 timestamps", Err: err}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+	if !query.End.IsZero() && query.End.Before(query.Start) {
+		valErr := &validator.ValidationError{Message: "end must not be before start"}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	points, err := h.seasonUseCase.GetRankHistory(ctx, req.UserID, query.Start, query.End)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, points, "Rank history retrieved successfully")
+}
+
+// GetTopMovers handles GET /seasons/top-movers, returning the biggest rank climbers and fallers between ended seasons
+func (h *SeasonHandler) GetTopMovers(c *gin.Context) {
+	var req application.GetTopMoversRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		valErr := &validator.ValidationError{Message: "since must be an RFC 3339 timestamp and limit an integer", Err: err}
+		apiErr := toAPIError(valErr)
+		h.logger.Err(c.Request.Context(), valErr).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	if err := validator.Validate(req); err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(c.Request.Context(), err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	movers, err := h.seasonUseCase.GetTopMovers(ctx, req.Since, req.Limit)
+	if err != nil {
+		apiErr := toAPIError(err)
+		h.logger.Err(ctx, err).Msg("Request error")
+		response.Error(c, apiErr)
+		return
+	}
+
+	response.Success(c, movers, "Top movers retrieved successfully")
+}
+
+// RegisterPublicRoutes registers public season routes (no auth required)
+func (h *SeasonHandler) RegisterPublicRoutes(router *gin.RouterGroup) {
+	router.GET("/seasons", h.ListSeasons)
+	router.GET("/seasons/users/:user_id/best-rank", h.GetBestRankEver)
+	router.GET("/seasons/users/:user_id/rank-history", h.GetRankHistory)
+	router.GET("/seasons/top-movers", h.GetTopMovers)
+}
+
+// RegisterAdminRoutes registers admin season routes (auth and admin role required)
+func (h *SeasonHandler) RegisterAdminRoutes(router *gin.RouterGroup) {
+	router.POST("/seasons", h.StartSeason)
+	router.POST("/seasons/end", h.EndSeason)
+}
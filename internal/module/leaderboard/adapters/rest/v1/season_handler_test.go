@@ -0,0 +1,239 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	lbmocks "real-time-leaderboard/internal/module/leaderboard/adapters/mocks"
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/logger"
+	"real-time-leaderboard/internal/shared/response"
+)
+
+func TestSeasonHandler_StartSeason_WhenValidName_ShouldReturn201WithSeason(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	startedAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	mockSeason := lbmocks.NewMockSeasonUseCase(ctrl)
+	mockSeason.EXPECT().
+		StartSeason(gomock.Any(), "Autumn").
+		Return(&domain.Season{ID: "season-2", Name: "Autumn", StartedAt: startedAt}, nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/admin/seasons", strings.NewReader(`{"name":"Autumn"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	h := NewSeasonHandler(mockSeason, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.StartSeason(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusCreated, w.Code)
+	var body struct {
+		Success bool          `json:"success"`
+		Data    domain.Season `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Success)
+	require.Equal(t, "season-2", body.Data.ID)
+	require.Nil(t, body.Data.EndedAt)
+}
+
+func TestSeasonHandler_EndSeason_WhenNoSeasonActive_ShouldReturn404(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeason := lbmocks.NewMockSeasonUseCase(ctrl)
+	mockSeason.EXPECT().EndSeason(gomock.Any()).Return(nil, domain.ErrNoActiveSeason).Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/admin/seasons/end", nil)
+
+	h := NewSeasonHandler(mockSeason, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.EndSeason(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusNotFound, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.False(t, body.Success)
+}
+
+func TestSeasonHandler_GetBestRankEver_WhenNoStandings_ShouldReturn404(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := "00000000-0000-0000-0000-000000000001"
+	mockSeason := lbmocks.NewMockSeasonUseCase(ctrl)
+	mockSeason.EXPECT().GetBestRankEver(gomock.Any(), userID).Return(nil, domain.ErrNoSeasonStandings).Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/seasons/users/"+userID+"/best-rank", nil)
+	c.Params = gin.Params{{Key: "user_id", Value: userID}}
+
+	h := NewSeasonHandler(mockSeason, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetBestRankEver(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSeasonHandler_GetBestRankEver_WhenUserIDNotUUID_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeason := lbmocks.NewMockSeasonUseCase(ctrl)
+	mockSeason.EXPECT().GetBestRankEver(gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/seasons/users/bogus/best-rank", nil)
+	c.Params = gin.Params{{Key: "user_id", Value: "bogus"}}
+
+	h := NewSeasonHandler(mockSeason, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetBestRankEver(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSeasonHandler_GetRankHistory_WhenRangeGiven_ShouldReturn200WithGaps(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := "00000000-0000-0000-0000-000000000001"
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+	mockSeason := lbmocks.NewMockSeasonUseCase(ctrl)
+	mockSeason.EXPECT().
+		GetRankHistory(gomock.Any(), userID, start, end).
+		Return([]domain.RankPoint{
+			{SeasonID: "season-1", Rank: 3, Score: 900, Ranked: true},
+			{SeasonID: "season-2"},
+			{SeasonID: "season-3", Rank: 1, Score: 1500, Ranked: true},
+		}, nil).
+		Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/seasons/users/"+userID+"/rank-history?start=2026-01-01T00:00:00Z&end=2026-06-30T00:00:00Z", nil)
+	c.Params = gin.Params{{Key: "user_id", Value: userID}}
+
+	h := NewSeasonHandler(mockSeason, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetRankHistory(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data []domain.RankPoint `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data, 3)
+	require.True(t, body.Data[0].Ranked)
+	require.False(t, body.Data[1].Ranked)
+	require.Zero(t, body.Data[1].Rank)
+	require.Equal(t, int64(1), body.Data[2].Rank)
+}
+
+func TestSeasonHandler_GetRankHistory_WhenEndBeforeStart_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := "00000000-0000-0000-0000-000000000001"
+	mockSeason := lbmocks.NewMockSeasonUseCase(ctrl)
+	mockSeason.EXPECT().GetRankHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/seasons/users/"+userID+"/rank-history?start=2026-06-30T00:00:00Z&end=2026-01-01T00:00:00Z", nil)
+	c.Params = gin.Params{{Key: "user_id", Value: userID}}
+
+	h := NewSeasonHandler(mockSeason, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetRankHistory(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, string(response.CodeValidation), body.Error.Code)
+}
+
+func TestSeasonHandler_GetTopMovers_WhenNotEnoughSeasons_ShouldReturn404(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeason := lbmocks.NewMockSeasonUseCase(ctrl)
+	mockSeason.EXPECT().GetTopMovers(gomock.Any(), time.Time{}, 5).Return(nil, domain.ErrNotEnoughSeasons).Times(1)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/seasons/top-movers?limit=5", nil)
+
+	h := NewSeasonHandler(mockSeason, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetTopMovers(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSeasonHandler_GetTopMovers_WhenLimitTooLarge_ShouldReturn400(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeason := lbmocks.NewMockSeasonUseCase(ctrl)
+	mockSeason.EXPECT().GetTopMovers(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/seasons/top-movers?limit=101", nil)
+
+	h := NewSeasonHandler(mockSeason, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	h.GetTopMovers(c)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
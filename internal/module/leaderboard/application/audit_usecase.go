@@ -0,0 +1,52 @@
+// Package application provides use cases for the leaderboard module.
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/database"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+//go:generate mockgen -destination=../adapters/mocks/audit_usecase_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application AuditUseCase
+
+// AuditUseCase defines the interface for querying the score submission audit log
+type AuditUseCase interface {
+	GetScoreAudit(ctx context.Context, userID string, limit, offset int64) ([]domain.ScoreAuditEntry, int64, error)
+}
+
+// auditUseCase implements AuditUseCase interface
+type auditUseCase struct {
+	auditRepo    ScoreAuditRepository
+	queryTimeout time.Duration
+	logger       *logger.Logger
+}
+
+// NewAuditUseCase creates a new audit use case.
+// queryTimeout bounds each audit query (0 disables).
+//
+//nolint:revive // unexported-return: intentional design - accept interface, return struct
+func NewAuditUseCase(auditRepo ScoreAuditRepository, queryTimeout time.Duration, l *logger.Logger) *auditUseCase {
+	return &auditUseCase{
+		auditRepo:    auditRepo,
+		queryTimeout: queryTimeout,
+		logger:       l,
+	}
+}
+
+// GetScoreAudit retrieves audit entries newest first, optionally filtered by user
+func (uc *auditUseCase) GetScoreAudit(ctx context.Context, userID string, limit, offset int64) ([]domain.ScoreAuditEntry, int64, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	entries, total, err := uc.auditRepo.List(ctx, userID, limit, offset)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to list score audit entries: %v", err)
+		return nil, 0, fmt.Errorf("failed to retrieve score audit: %w", err)
+	}
+
+	return entries, total, nil
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/module/leaderboard/infrastructure/mocks"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+func TestAuditUseCase_GetScoreAudit_WhenRepositorySucceeds_ShouldReturnEntries(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expected := []domain.ScoreAuditEntry{
+		{ID: "a-2", UserID: "user-1", Score: 900, Accepted: false, Reason: "email not verified"},
+		{ID: "a-1", UserID: "user-1", Score: 800, Accepted: true},
+	}
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		List(ctx, "user-1", int64(10), int64(0)).
+		Return(expected, int64(2), nil).
+		Times(1)
+
+	uc := NewAuditUseCase(mockAuditRepo, 0, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, total, err := uc.GetScoreAudit(ctx, "user-1", 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(2), total)
+	require.Equal(t, expected, entries)
+}
+
+func TestAuditUseCase_GetScoreAudit_WhenRepositoryFails_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		List(ctx, "", int64(10), int64(0)).
+		Return(nil, int64(0), errors.New("db error")).
+		Times(1)
+
+	uc := NewAuditUseCase(mockAuditRepo, 0, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, total, err := uc.GetScoreAudit(ctx, "", 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Nil(t, entries)
+	require.Zero(t, total)
+	require.Contains(t, err.Error(), "failed to retrieve score audit")
+}
@@ -0,0 +1,36 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// loadBoard reads up to MaxBroadcastRank entries from persistence, best first, and backfills them into the cache,
+// so every read of a page within that range, and every write, is served from the cache afterwards.
+// The board is only marked loaded once every entry was written; otherwise the next read or write tries again.
+// Returns the loaded entries and the total number of ranked players in persistence.
+func loadBoard(ctx context.Context, cacheRepo LeaderboardCacheRepository, persistenceRepo LeaderboardPersistenceRepository, l *logger.Logger) ([]domain.LeaderboardEntry, int64, error) {
+	entries, total, err := persistenceRepo.GetLeaderboard(ctx, int64(domain.MaxBroadcastRank), 0)
+	if err != nil {
+		l.Errorf(ctx, "Failed to get leaderboard from persistence: %v", err)
+		return nil, 0, fmt.Errorf("failed to retrieve leaderboard: %w", err)
+	}
+
+	backfilled := true
+	for _, e := range entries {
+		if err := cacheRepo.UpdateScore(ctx, e.UserID, e.Score); err != nil {
+			l.Warnf(ctx, "Failed to backfill cache for user %s: %v", e.UserID, err)
+			backfilled = false
+		}
+	}
+	if backfilled {
+		if err := cacheRepo.MarkLoaded(ctx); err != nil {
+			l.Warnf(ctx, "Failed to mark cache loaded: %v", err)
+		}
+	}
+
+	return entries, total, nil
+}
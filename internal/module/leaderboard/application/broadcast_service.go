@@ -5,12 +5,17 @@ package application
 
 import (
 	"context"
+	"time"
 
 	"real-time-leaderboard/internal/module/leaderboard/domain"
 )
 
-// BroadcastService defines the interface for broadcasting leaderboard entry delta updates
+// BroadcastService defines the interface for broadcasting leaderboard entry delta updates and viewer counts
 type BroadcastService interface {
 	BroadcastEntryUpdate(ctx context.Context, entry *domain.LeaderboardEntry) error
-	SubscribeToEntryUpdates(ctx context.Context) (<-chan *domain.LeaderboardEntry, error)
+	// SubscribeToStreamUpdates delivers entry delta updates and viewer counts in publish order
+	SubscribeToStreamUpdates(ctx context.Context) (<-chan domain.StreamUpdate, error)
+	BroadcastViewerCount(ctx context.Context, count int64) error
+	// LastEntryPublishAt returns when this instance last published an entry update, or the zero time if it never has
+	LastEntryPublishAt() time.Time
 }
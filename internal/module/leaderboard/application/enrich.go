@@ -0,0 +1,32 @@
+package application
+
+import "context"
+
+// skipUsernamesKey is the context key marking reads whose entries are returned without usernames
+type skipUsernamesKey struct{}
+
+// WithoutUsernames returns a context whose reads skip the username lookup and leave usernames empty,
+// saving the PostgreSQL round-trip for callers that only need IDs and scores
+func WithoutUsernames(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipUsernamesKey{}, true)
+}
+
+// UsernamesSkipped reports whether ctx comes from WithoutUsernames
+func UsernamesSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipUsernamesKey{}).(bool)
+	return skip
+}
+
+// includeTimestampsKey is the context key marking reads whose entries carry their update time
+type includeTimestampsKey struct{}
+
+// WithTimestamps returns a context whose reads also set each entry's UpdatedAt, at the cost of a PostgreSQL lookup
+func WithTimestamps(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeTimestampsKey{}, true)
+}
+
+// TimestampsIncluded reports whether ctx comes from WithTimestamps
+func TimestampsIncluded(ctx context.Context) bool {
+	include, _ := ctx.Value(includeTimestampsKey{}).(bool)
+	return include
+}
@@ -0,0 +1,69 @@
+// Package application provides use cases for the leaderboard module.
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// inactivityEvictor periodically removes players who stopped submitting scores from the leaderboard cache
+type inactivityEvictor struct {
+	cacheRepo LeaderboardCacheRepository
+	window    time.Duration
+	interval  time.Duration
+	now       func() time.Time
+	logger    *logger.Logger
+}
+
+// NewInactivityEvictor creates an evictor removing players whose last submission is older than window
+//
+//nolint:revive // unexported-return: intentional design - accept interface, return struct
+func NewInactivityEvictor(
+	cacheRepo LeaderboardCacheRepository,
+	window, interval time.Duration,
+	l *logger.Logger,
+) *inactivityEvictor {
+	return &inactivityEvictor{
+		cacheRepo: cacheRepo,
+		window:    window,
+		interval:  interval,
+		now:       time.Now,
+		logger:    l,
+	}
+}
+
+// Run evicts inactive players every interval until ctx is cancelled
+func (e *inactivityEvictor) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := e.EvictInactive(ctx); err != nil {
+				e.logger.Warnf(ctx, "Failed to evict inactive players: %v", err)
+			}
+		}
+	}
+}
+
+// EvictInactive removes players whose last score submission is older than the inactivity window
+// and returns how many were removed. Only the cache board is affected; persisted scores are kept, so reads that
+// fall back to PostgreSQL, and a reload of an expired or reset board, include evicted players again.
+func (e *inactivityEvictor) EvictInactive(ctx context.Context) (int, error) {
+	removed, err := e.cacheRepo.RemoveInactiveUsers(ctx, e.now().Add(-e.window))
+	if err != nil {
+		return 0, fmt.Errorf("failed to evict inactive players: %w", err)
+	}
+
+	if len(removed) > 0 {
+		e.logger.Infof(ctx, "Evicted %d inactive players from leaderboard", len(removed))
+	}
+
+	return len(removed), nil
+}
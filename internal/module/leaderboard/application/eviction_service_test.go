@@ -0,0 +1,61 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"real-time-leaderboard/internal/module/leaderboard/infrastructure/mocks"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+func TestInactivityEvictor_EvictInactive_WhenPlayersInactive_ShouldRemoveUsersOlderThanWindow(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		RemoveInactiveUsers(ctx, now.Add(-24*time.Hour)).
+		Return([]string{"user-1", "user-2"}, nil).
+		Times(1)
+
+	evictor := NewInactivityEvictor(mockCacheRepo, 24*time.Hour, time.Minute, logger.New("info", false))
+	evictor.now = func() time.Time { return now }
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	removed, err := evictor.EvictInactive(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+}
+
+func TestInactivityEvictor_EvictInactive_WhenCacheFails_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		RemoveInactiveUsers(ctx, gomock.Any()).
+		Return(nil, errors.New("redis error")).
+		Times(1)
+
+	evictor := NewInactivityEvictor(mockCacheRepo, 24*time.Hour, time.Minute, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	removed, err := evictor.EvictInactive(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Zero(t, removed)
+	require.Contains(t, err.Error(), "redis error")
+}
@@ -0,0 +1,16 @@
+// Package application provides use cases for the leaderboard module.
+package application
+
+//go:generate mockgen -destination=../infrastructure/mocks/leader_notifier_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application LeaderNotifier
+
+import (
+	"context"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+)
+
+// LeaderNotifier defines the interface for notifying external systems when a user takes rank 1.
+// Implementations must not block the caller.
+type LeaderNotifier interface {
+	NotifyNewLeader(ctx context.Context, event *domain.NewLeaderEvent) error
+}
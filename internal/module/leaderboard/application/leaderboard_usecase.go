@@ -4,9 +4,13 @@ package application
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/database"
 	"real-time-leaderboard/internal/shared/logger"
+
+	"github.com/google/uuid"
 )
 
 //go:generate mockgen -destination=../adapters/mocks/leaderboard_usecase_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application LeaderboardUseCase
@@ -14,7 +18,18 @@ import (
 // LeaderboardUseCase defines the interface for leaderboard operations
 type LeaderboardUseCase interface {
 	GetLeaderboard(ctx context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error)
-	SubscribeToEntryUpdates(ctx context.Context) (<-chan *domain.LeaderboardEntry, error)
+	GetUserRank(ctx context.Context, userID string) (*domain.LeaderboardEntry, error)
+	GetTotalPlayers(ctx context.Context) (int64, error)
+	GetUserRanks(ctx context.Context, userIDs []string) ([]domain.UserRankEntry, error)
+	GetUsernames(ctx context.Context, userIDs []string) (map[string]string, error)
+	GetGapToNext(ctx context.Context, userID string) (domain.GapInfo, error)
+	GetStanding(ctx context.Context, userID string, radius int64) (domain.Standing, error)
+	GetViewerCount(ctx context.Context) (int64, error)
+	GetBroadcastStatus(ctx context.Context) domain.BroadcastStatus
+	WaitForVersionChange(ctx context.Context, since int64, timeout time.Duration) (int64, error)
+	GetUpdatedAt(ctx context.Context) (time.Time, error)
+	GetScoreHistogram(ctx context.Context, buckets int) ([]domain.ScoreBucket, error)
+	SubscribeToStreamUpdates(ctx context.Context) (<-chan domain.StreamUpdate, error)
 }
 
 // leaderboardUseCase implements LeaderboardUseCase interface
@@ -23,10 +38,44 @@ type leaderboardUseCase struct {
 	persistenceRepo  LeaderboardPersistenceRepository
 	userRepo         UserRepository
 	broadcastService BroadcastService
-	logger           *logger.Logger
+	presenceRepo     ViewerPresenceRepository
+	queryTimeout     time.Duration
+	usernames        UsernameConfig
+	// viewerHeartbeat is how often a stream refreshes its presence
+	viewerHeartbeat time.Duration
+	logger          *logger.Logger
+}
+
+// GetUserRanksRequest represents a bulk rank lookup, e.g. for a friends list
+type GetUserRanksRequest struct {
+	UserIDs []string `json:"user_ids" validate:"required,min=1,max=100,dive,uuid"`
+}
+
+// GetUsernamesRequest represents a batch username lookup for clients that cache leaderboard data themselves
+type GetUsernamesRequest struct {
+	UserIDs []string `json:"ids" validate:"required,min=1,max=100,dive,uuid"`
+}
+
+// DefaultHistogramBuckets is the number of score histogram buckets used when the request does not set one
+const DefaultHistogramBuckets = 10
+
+// GetScoreHistogramRequest represents a score distribution query
+type GetScoreHistogramRequest struct {
+	Buckets int `form:"buckets" validate:"omitempty,min=1,max=100"`
+}
+
+// DefaultStandingRadius is the number of players above and below the caller returned when the request does not set one
+const DefaultStandingRadius = 3
+
+// GetStandingRequest represents a "my standing" query
+type GetStandingRequest struct {
+	Radius int64 `form:"radius" validate:"omitempty,min=1,max=25"`
 }
 
-// NewLeaderboardUseCase creates a new leaderboard use case
+// NewLeaderboardUseCase creates a new leaderboard use case.
+// presenceRepo may be nil to disable viewer counting.
+// queryTimeout bounds the repository calls of each read (0 disables); subscriptions are not bounded.
+// usernames decides what entries show when their usernames cannot be loaded.
 //
 //nolint:revive // unexported-return: intentional design - accept interface, return struct
 func NewLeaderboardUseCase(
@@ -34,6 +83,9 @@ func NewLeaderboardUseCase(
 	persistenceRepo LeaderboardPersistenceRepository,
 	userRepo UserRepository,
 	broadcastService BroadcastService,
+	presenceRepo ViewerPresenceRepository,
+	queryTimeout time.Duration,
+	usernames UsernameConfig,
 	l *logger.Logger,
 ) *leaderboardUseCase {
 	return &leaderboardUseCase{
@@ -41,21 +93,34 @@ func NewLeaderboardUseCase(
 		persistenceRepo:  persistenceRepo,
 		userRepo:         userRepo,
 		broadcastService: broadcastService,
+		presenceRepo:     presenceRepo,
+		queryTimeout:     queryTimeout,
+		usernames:        usernames,
+		viewerHeartbeat:  domain.ViewerHeartbeatInterval,
 		logger:           l,
 	}
 }
 
 // GetLeaderboard retrieves a paginated leaderboard with username enrichment.
 // Cache-aside strategy: tries cache first; on cache miss loads up to MaxBroadcastRank entries and backfills cache; on cache error uses persistence directly without backfilling.
+// A non-empty board only counts as a hit while its loaded marker is present, so a board that expired and was
+// recreated by a few writes is reloaded rather than served with just those players.
 func (uc *leaderboardUseCase) GetLeaderboard(ctx context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
 	// Try cache first with requested limit/offset
 	entries, total, err := uc.cacheRepo.GetLeaderboard(ctx, limit, offset)
-	
-	// Cache hit: no error and cache has data
+	loaded := false
 	if err == nil && total > 0 {
+		loaded, err = uc.cacheRepo.IsLoaded(ctx)
+	}
+
+	// Cache hit: no error and cache has data loaded from persistence
+	if err == nil && loaded {
 		// Cache hit - enrich and return requested page
-		if err := uc.enrichEntriesWithUsernames(ctx, entries); err != nil {
-			uc.logger.Warnf(ctx, "Failed to enrich entries with usernames: %v", err)
+		if err := uc.enrichEntries(ctx, entries); err != nil {
+			return nil, 0, err
 		}
 		return entries, total, nil
 	}
@@ -69,29 +134,20 @@ func (uc *leaderboardUseCase) GetLeaderboard(ctx context.Context, limit, offset
 			return nil, 0, fmt.Errorf("failed to retrieve leaderboard: %w", err)
 		}
 		// Enrich and return - don't backfill cache when it's broken
-		if err := uc.enrichEntriesWithUsernames(ctx, entries); err != nil {
-			uc.logger.Warnf(ctx, "Failed to enrich entries with usernames: %v", err)
+		if err := uc.enrichEntries(ctx, entries); err != nil {
+			return nil, 0, err
 		}
 		return entries, total, nil
 	}
 
-	// Cache miss (empty): load up to MaxBroadcastRank entries and backfill cache
-	uc.logger.Warnf(ctx, "Cache empty, loading full leaderboard from database and backfilling cache")
+	// Cache miss (empty or not loaded): load up to MaxBroadcastRank entries and backfill cache
+	uc.logger.Warnf(ctx, "Cache not loaded, loading full leaderboard from database and backfilling cache")
 	
 	// Load up to MaxBroadcastRank entries to populate cache fully
 	// This ensures subsequent requests for any limit <= MaxBroadcastRank will be served from cache
-	loadLimit := int64(domain.MaxBroadcastRank)
-	allEntries, total, err := uc.persistenceRepo.GetLeaderboard(ctx, loadLimit, 0)
+	allEntries, total, err := loadBoard(ctx, uc.cacheRepo, uc.persistenceRepo, uc.logger)
 	if err != nil {
-		uc.logger.Errorf(ctx, "Failed to get leaderboard from persistence: %v", err)
-		return nil, 0, fmt.Errorf("failed to retrieve leaderboard: %w", err)
-	}
-
-	// Backfill cache with all loaded entries
-	for _, e := range allEntries {
-		if err := uc.cacheRepo.UpdateScore(ctx, e.UserID, e.Score); err != nil {
-			uc.logger.Warnf(ctx, "Failed to backfill cache for user %s: %v", e.UserID, err)
-		}
+		return nil, 0, err
 	}
 
 	// Extract requested page from loaded entries (entries are already ranked from persistence)
@@ -106,14 +162,242 @@ func (uc *leaderboardUseCase) GetLeaderboard(ctx context.Context, limit, offset
 	
 	// Extract and enrich only the requested page entries
 	pageEntries := allEntries[o:end]
-	if err := uc.enrichEntriesWithUsernames(ctx, pageEntries); err != nil {
-		uc.logger.Warnf(ctx, "Failed to enrich entries with usernames: %v", err)
+	if err := uc.enrichEntries(ctx, pageEntries); err != nil {
+		return nil, 0, err
 	}
 
 	return pageEntries, total, nil
 }
 
-func (uc *leaderboardUseCase) enrichEntriesWithUsernames(ctx context.Context, entries []domain.LeaderboardEntry) error {
+// GetUserRank retrieves a user's leaderboard entry enriched with username.
+// Returns nil without error when the user is not in the leaderboard.
+// When the cache errors, the rank is computed from persistence instead, where tied users share a rank.
+func (uc *leaderboardUseCase) GetUserRank(ctx context.Context, userID string) (*domain.LeaderboardEntry, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	entry, err := uc.cacheRepo.GetUserEntry(ctx, userID)
+	if err != nil {
+		uc.logger.Warnf(ctx, "Cache error, computing user rank from persistence: %v", err)
+		entry, err = uc.persistenceRepo.GetUserEntry(ctx, userID)
+		if err != nil {
+			uc.logger.Errorf(ctx, "Failed to get user rank from persistence: %v", err)
+			return nil, fmt.Errorf("failed to retrieve user rank: %w", err)
+		}
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	entries := []domain.LeaderboardEntry{*entry}
+	if err := uc.enrichEntries(ctx, entries); err != nil {
+		return nil, err
+	}
+
+	return &entries[0], nil
+}
+
+// GetTotalPlayers returns the number of ranked players.
+// Uses the cache count and falls back to persistence when the cache errors, is empty or was not loaded from persistence.
+func (uc *leaderboardUseCase) GetTotalPlayers(ctx context.Context) (int64, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	total, err := uc.cacheRepo.GetTotalPlayers(ctx)
+	loaded := false
+	if err == nil && total > 0 {
+		loaded, err = uc.cacheRepo.IsLoaded(ctx)
+	}
+	if err == nil && loaded {
+		return total, nil
+	}
+	if err != nil {
+		uc.logger.Warnf(ctx, "Cache error, counting players from persistence: %v", err)
+	}
+
+	total, err = uc.persistenceRepo.GetTotalPlayers(ctx)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to count players from persistence: %v", err)
+		return 0, fmt.Errorf("failed to retrieve total players: %w", err)
+	}
+
+	return total, nil
+}
+
+// GetUserRanks looks up several users at once, returning one entry per requested ID in request order.
+// Users not on the board are included with InLeaderboard=false so callers can render a stable list.
+func (uc *leaderboardUseCase) GetUserRanks(ctx context.Context, userIDs []string) ([]domain.UserRankEntry, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	ranked, err := uc.cacheRepo.GetUserEntries(ctx, userIDs)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get user ranks: %v", err)
+		return nil, fmt.Errorf("failed to retrieve user ranks: %w", err)
+	}
+
+	usernames, err := lookupUsernames(ctx, uc.userRepo, uc.usernames, uc.logger, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.UserRankEntry, len(userIDs))
+	for i, userID := range userIDs {
+		entry, ok := ranked[userID]
+		if !ok {
+			entry = domain.LeaderboardEntry{UserID: userID}
+		}
+		entry.Username = usernames[userID]
+		results[i] = domain.UserRankEntry{LeaderboardEntry: entry, InLeaderboard: ok}
+	}
+
+	return results, nil
+}
+
+// GetUsernames resolves the usernames of the given users, keyed by user ID.
+// Unknown IDs are left out of the map rather than given a fallback, so callers can tell them apart.
+func (uc *leaderboardUseCase) GetUsernames(ctx context.Context, userIDs []string) (map[string]string, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	usernames, err := uc.userRepo.GetByIDs(ctx, userIDs)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get usernames: %v", err)
+		return nil, fmt.Errorf("failed to retrieve usernames: %w", err)
+	}
+
+	return usernames, nil
+}
+
+// GetGapToNext returns the score difference between the user and the player ranked directly above them.
+// Returns domain.ErrUserNotInLeaderboard when the user is not on the board.
+func (uc *leaderboardUseCase) GetGapToNext(ctx context.Context, userID string) (domain.GapInfo, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	entry, err := uc.cacheRepo.GetUserEntry(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get user rank: %v", err)
+		return domain.GapInfo{}, fmt.Errorf("failed to retrieve score gap: %w", err)
+	}
+	if entry == nil {
+		return domain.GapInfo{}, domain.ErrUserNotInLeaderboard
+	}
+
+	gap := domain.GapInfo{UserID: userID, Score: entry.Score, Rank: entry.Rank, IsLeader: entry.Rank == 1}
+	if gap.IsLeader {
+		return gap, nil
+	}
+
+	// Rank is 1-based, so the player at rank-1 sits at offset rank-2
+	above, _, err := uc.cacheRepo.GetLeaderboard(ctx, 1, entry.Rank-2)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get next player: %v", err)
+		return domain.GapInfo{}, fmt.Errorf("failed to retrieve score gap: %w", err)
+	}
+	if len(above) == 0 {
+		// The players above left the board between the two reads
+		gap.IsLeader = true
+		return gap, nil
+	}
+
+	// The difference is absolute so the gap reads the same on ascending boards
+	gap.ScoreGap = above[0].Score - entry.Score
+	if gap.ScoreGap < 0 {
+		gap.ScoreGap = -gap.ScoreGap
+	}
+
+	return gap, nil
+}
+
+// GetStanding returns the user's entry with up to radius players ranked directly above and below it, enriched
+// with usernames. A user not on the board gets InLeaderboard=false and no entries.
+func (uc *leaderboardUseCase) GetStanding(ctx context.Context, userID string, radius int64) (domain.Standing, error) {
+	if radius <= 0 {
+		radius = DefaultStandingRadius
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	standing := domain.Standing{Above: []domain.LeaderboardEntry{}, Below: []domain.LeaderboardEntry{}}
+	entry, err := uc.cacheRepo.GetUserEntry(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get user rank: %v", err)
+		return domain.Standing{}, fmt.Errorf("failed to retrieve standing: %w", err)
+	}
+	if entry == nil {
+		return standing, nil
+	}
+
+	// Rank is 1-based, so the user sits at offset rank-1 and the window starts radius places above
+	offset := max(entry.Rank-1-radius, 0)
+	window, _, err := uc.cacheRepo.GetLeaderboard(ctx, entry.Rank+radius-offset, offset)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get neighboring players: %v", err)
+		return domain.Standing{}, fmt.Errorf("failed to retrieve standing: %w", err)
+	}
+
+	// Neighbors are placed by the user's rank from the first read, which holds even if the user moved in between
+	entries := make([]domain.LeaderboardEntry, 0, len(window)+1)
+	for _, e := range window {
+		if e.UserID != userID {
+			entries = append(entries, e)
+		}
+	}
+	entries = append(entries, *entry)
+	if err := uc.enrichEntries(ctx, entries); err != nil {
+		return domain.Standing{}, err
+	}
+
+	standing.InLeaderboard = true
+	standing.Self = &entries[len(entries)-1]
+	for _, e := range entries[:len(entries)-1] {
+		if e.Rank < entry.Rank {
+			standing.Above = append(standing.Above, e)
+		} else {
+			standing.Below = append(standing.Below, e)
+		}
+	}
+
+	return standing, nil
+}
+
+// GetScoreHistogram returns the score distribution of the board split into at most buckets equal-width ranges
+// between the lowest and highest score. An empty board yields no buckets; a single distinct score yields one.
+func (uc *leaderboardUseCase) GetScoreHistogram(ctx context.Context, buckets int) ([]domain.ScoreBucket, error) {
+	if buckets <= 0 {
+		buckets = DefaultHistogramBuckets
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	lowest, highest, ok, err := uc.cacheRepo.GetScoreBounds(ctx)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get score bounds: %v", err)
+		return nil, fmt.Errorf("failed to retrieve score histogram: %w", err)
+	}
+	if !ok {
+		return []domain.ScoreBucket{}, nil
+	}
+
+	histogram := domain.NewScoreBuckets(lowest, highest, buckets)
+	counts, err := uc.cacheRepo.CountScores(ctx, histogram)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to count scores: %v", err)
+		return nil, fmt.Errorf("failed to retrieve score histogram: %w", err)
+	}
+	for i := range histogram {
+		histogram[i].Count = counts[i]
+	}
+
+	return histogram, nil
+}
+
+// enrichEntries fills in each entry's username, and its update time on a context from WithTimestamps.
+// It only fails when usernames are required; update times that cannot be loaded are left unset.
+func (uc *leaderboardUseCase) enrichEntries(ctx context.Context, entries []domain.LeaderboardEntry) error {
 	if len(entries) == 0 {
 		return nil
 	}
@@ -123,24 +407,184 @@ func (uc *leaderboardUseCase) enrichEntriesWithUsernames(ctx context.Context, en
 		userIDs = append(userIDs, entry.UserID)
 	}
 
-	usernames, err := uc.userRepo.GetByIDs(ctx, userIDs)
+	usernames, err := lookupUsernames(ctx, uc.userRepo, uc.usernames, uc.logger, userIDs)
 	if err != nil {
 		return err
 	}
 
+	var updatedTimes map[string]time.Time
+	if TimestampsIncluded(ctx) {
+		updatedTimes, err = uc.persistenceRepo.GetUpdatedTimes(ctx, userIDs)
+		if err != nil {
+			uc.logger.Warnf(ctx, "Failed to enrich entries with update times: %v", err)
+		}
+	}
+
 	for i := range entries {
-		if username, ok := usernames[entries[i].UserID]; ok {
-			entries[i].Username = username
-		} else {
-			uc.logger.Warnf(ctx, "Username not found for user ID: %s", entries[i].UserID)
-			entries[i].Username = ""
+		entries[i].Username = usernames[entries[i].UserID]
+		if updatedAt, ok := updatedTimes[entries[i].UserID]; ok {
+			entries[i].UpdatedAt = &updatedAt
 		}
 	}
 
 	return nil
 }
 
-// SubscribeToEntryUpdates subscribes to leaderboard entry delta update broadcasts for SSE handlers
-func (uc *leaderboardUseCase) SubscribeToEntryUpdates(ctx context.Context) (<-chan *domain.LeaderboardEntry, error) {
-	return uc.broadcastService.SubscribeToEntryUpdates(ctx)
+// WaitForVersionChange returns the board version as soon as it differs from since, re-reading it every
+// domain.VersionPollInterval for up to timeout. The version is always read once, even with a zero timeout.
+// When the wait ends without a change, since is returned without error so callers can report no change.
+func (uc *leaderboardUseCase) WaitForVersionChange(ctx context.Context, since int64, timeout time.Duration) (int64, error) {
+	version, err := uc.getVersion(ctx)
+	if err != nil || version != since {
+		return version, err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(domain.VersionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-waitCtx.Done():
+			return since, nil
+		case <-ticker.C:
+		}
+
+		version, err := uc.getVersion(waitCtx)
+		if err != nil && waitCtx.Err() != nil {
+			return since, nil
+		}
+		if err != nil || version != since {
+			return version, err
+		}
+	}
+}
+
+// getVersion reads the board version bounded by the query timeout
+func (uc *leaderboardUseCase) getVersion(ctx context.Context) (int64, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	version, err := uc.cacheRepo.GetVersion(ctx)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get leaderboard version: %v", err)
+		return 0, fmt.Errorf("failed to retrieve leaderboard version: %w", err)
+	}
+
+	return version, nil
+}
+
+// GetUpdatedAt returns when the board's scores last changed; the zero time before the first change
+func (uc *leaderboardUseCase) GetUpdatedAt(ctx context.Context) (time.Time, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	updatedAt, err := uc.cacheRepo.GetUpdatedAt(ctx)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get leaderboard update time: %v", err)
+		return time.Time{}, fmt.Errorf("failed to retrieve leaderboard update time: %w", err)
+	}
+
+	return updatedAt, nil
+}
+
+// GetBroadcastStatus returns when this instance last published an entry update and how long ago that was
+func (uc *leaderboardUseCase) GetBroadcastStatus(_ context.Context) domain.BroadcastStatus {
+	last := uc.broadcastService.LastEntryPublishAt()
+	if last.IsZero() {
+		return domain.BroadcastStatus{}
+	}
+	return domain.BroadcastStatus{LastPublishAt: last, LagSeconds: time.Since(last).Seconds()}
+}
+
+// GetViewerCount returns the number of live stream connections; always 0 when presence is disabled
+func (uc *leaderboardUseCase) GetViewerCount(ctx context.Context) (int64, error) {
+	if uc.presenceRepo == nil {
+		return 0, nil
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	count, err := uc.presenceRepo.Count(ctx)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to count viewers: %v", err)
+		return 0, fmt.Errorf("failed to retrieve viewer count: %w", err)
+	}
+
+	return count, nil
+}
+
+// SubscribeToStreamUpdates subscribes to leaderboard entry delta updates and viewer counts for SSE handlers.
+// The subscriber counts as a viewer until ctx is done.
+func (uc *leaderboardUseCase) SubscribeToStreamUpdates(ctx context.Context) (<-chan domain.StreamUpdate, error) {
+	ch, err := uc.broadcastService.SubscribeToStreamUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.presenceRepo != nil {
+		go uc.trackViewer(ctx, uuid.NewString())
+	}
+
+	return ch, nil
+}
+
+// trackViewer keeps the connection's presence alive until ctx is done, then removes it.
+// The count is published on join and leave, and on a heartbeat only when it changed since the last one published,
+// e.g. after another server's viewers expired. Presence failures are logged only; the stream is served regardless.
+func (uc *leaderboardUseCase) trackViewer(ctx context.Context, connectionID string) {
+	last, joined := uc.touchViewer(ctx, connectionID)
+	if joined {
+		uc.publishViewerCount(ctx, last)
+	}
+
+	ticker := time.NewTicker(uc.viewerHeartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if count, ok := uc.touchViewer(ctx, connectionID); ok && (!joined || count != last) {
+				uc.publishViewerCount(ctx, count)
+				last, joined = count, true
+			}
+		case <-ctx.Done():
+			uc.leaveViewer(ctx, connectionID)
+			return
+		}
+	}
+}
+
+// leaveViewer removes the connection once its request context is gone, using a fresh deadline
+func (uc *leaderboardUseCase) leaveViewer(ctx context.Context, connectionID string) {
+	ctx, cancel := database.WithQueryTimeout(context.WithoutCancel(ctx), uc.queryTimeout)
+	defer cancel()
+
+	count, err := uc.presenceRepo.Leave(ctx, connectionID)
+	if err != nil {
+		uc.logger.Warnf(ctx, "Failed to remove viewer presence: %v", err)
+		return
+	}
+	uc.publishViewerCount(ctx, count)
+}
+
+// touchViewer refreshes the connection's presence and returns the viewer count; ok is false when that failed
+func (uc *leaderboardUseCase) touchViewer(ctx context.Context, connectionID string) (count int64, ok bool) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	count, err := uc.presenceRepo.Touch(ctx, connectionID, domain.ViewerPresenceTTL)
+	if err != nil {
+		uc.logger.Warnf(ctx, "Failed to record viewer presence: %v", err)
+		return 0, false
+	}
+	return count, true
+}
+
+func (uc *leaderboardUseCase) publishViewerCount(ctx context.Context, count int64) {
+	if err := uc.broadcastService.BroadcastViewerCount(ctx, count); err != nil {
+		uc.logger.Warnf(ctx, "Failed to broadcast viewer count: %v", err)
+	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -35,6 +36,10 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheHit_ShouldReturnFromCacheAnd
 			{UserID: "user-10", Score: 10, Rank: 10},
 		}, int64(10), nil).
 		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockUserRepo.EXPECT().
@@ -50,7 +55,7 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheHit_ShouldReturnFromCacheAnd
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
@@ -63,6 +68,203 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheHit_ShouldReturnFromCacheAnd
 	require.Equal(t, "bob", entries[1].Username)
 }
 
+func TestLeaderboardUseCase_GetLeaderboard_WhenWithoutUsernames_ShouldSkipUserRepo(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := WithoutUsernames(context.Background())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{
+			{UserID: "user-1", Score: 1000, Rank: 1},
+			{UserID: "user-2", Score: 500, Rank: 2},
+		}, int64(2), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
+
+	// No GetByIDs expectation: any username lookup fails the test
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{Fallback: "Player {id}", Required: true}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(2), total)
+	require.Equal(t, []domain.LeaderboardEntry{
+		{UserID: "user-1", Score: 1000, Rank: 1},
+		{UserID: "user-2", Score: 500, Rank: 2},
+	}, entries)
+}
+
+func TestLeaderboardUseCase_GetLeaderboard_WhenWithTimestamps_ShouldSetUpdatedAtFromPersistence(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := WithTimestamps(context.Background())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{
+			{UserID: "user-1", Score: 1000, Rank: 1},
+			{UserID: "user-2", Score: 500, Rank: 2},
+		}, int64(2), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1", "user-2"}).
+		Return(map[string]string{"user-1": "alice", "user-2": "bob"}, nil).
+		Times(1)
+
+	// user-2 has no persisted score (e.g. only cached so far), so it gets no timestamp
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetUpdatedTimes(ctx, []string{"user-1", "user-2"}).
+		Return(map[string]time.Time{"user-1": updatedAt}, nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, nil, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, _, err := uc.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.NotNil(t, entries[0].UpdatedAt)
+	require.Equal(t, updatedAt, *entries[0].UpdatedAt)
+	require.Nil(t, entries[1].UpdatedAt)
+	require.Equal(t, "alice", entries[0].Username)
+}
+
+func TestLeaderboardUseCase_GetLeaderboard_WhenTimestampsNotRequested_ShouldNotLoadThem(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{{UserID: "user-1", Score: 1000, Rank: 1}}, int64(1), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1"}).
+		Return(map[string]string{"user-1": "alice"}, nil).
+		Times(1)
+
+	// No GetUpdatedTimes expectation: loading timestamps fails the test
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, nil, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, _, err := uc.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Nil(t, entries[0].UpdatedAt)
+}
+
+func TestLeaderboardUseCase_GetLeaderboard_WhenTimestampLookupFails_ShouldStillReturnEntries(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := WithTimestamps(context.Background())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{{UserID: "user-1", Score: 1000, Rank: 1}}, int64(1), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1"}).
+		Return(map[string]string{"user-1": "alice"}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetUpdatedTimes(ctx, []string{"user-1"}).
+		Return(nil, errors.New("db down")).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, nil, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, _, err := uc.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "alice", entries[0].Username)
+	require.Nil(t, entries[0].UpdatedAt)
+}
+
+func TestLeaderboardUseCase_GetUserRanks_WhenWithoutUsernames_ShouldSkipUserRepo(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := WithoutUsernames(context.Background())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userIDs := []string{"user-1", "user-404"}
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntries(ctx, userIDs).
+		Return(map[string]domain.LeaderboardEntry{"user-1": {UserID: "user-1", Score: 1500, Rank: 1}}, nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, nil, mockUserRepo, nil, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, err := uc.GetUserRanks(ctx, userIDs)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Empty(t, entries[0].Username)
+	require.True(t, entries[0].InLeaderboard)
+	require.Empty(t, entries[1].Username)
+	require.False(t, entries[1].InLeaderboard)
+}
+
 func TestLeaderboardUseCase_GetLeaderboard_WhenCacheHit_ShouldUseCacheRegardlessOfLimit(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	ctx := context.Background()
@@ -78,6 +280,10 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheHit_ShouldUseCacheRegardless
 			{UserID: "user-2", Score: 500, Rank: 2},
 		}, int64(2), nil).
 		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockUserRepo.EXPECT().
@@ -89,7 +295,7 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheHit_ShouldUseCacheRegardless
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
@@ -128,6 +334,11 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheMiss_ShouldLoadFromPersisten
 		Return(nil).
 		Times(1)
 
+	mockCacheRepo.EXPECT().
+		MarkLoaded(ctx).
+		Return(nil).
+		Times(1)
+
 	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
 	// Load up to MaxBroadcastRank (1000) entries
 	mockPersistenceRepo.EXPECT().
@@ -149,7 +360,7 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheMiss_ShouldLoadFromPersisten
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	entries, total, err := uc.GetLeaderboard(ctx, 2, 0)
@@ -193,7 +404,7 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheError_ShouldUsePersistenceDi
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
@@ -236,7 +447,7 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheError_ShouldUsePersistenceDi
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
@@ -248,6 +459,106 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheError_ShouldUsePersistenceDi
 	require.Equal(t, "alice", entries[0].Username)
 }
 
+func TestLeaderboardUseCase_GetLeaderboard_WhenCacheNotLoaded_ShouldReloadFromPersistenceAndMarkLoaded(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	// The board expired and one write recreated it with a single player
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{{UserID: "user-2", Score: 500, Rank: 1}}, int64(1), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(false, nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		UpdateScore(ctx, "user-1", int64(1000)).
+		Return(nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		UpdateScore(ctx, "user-2", int64(500)).
+		Return(nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		MarkLoaded(ctx).
+		Return(nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetLeaderboard(ctx, int64(domain.MaxBroadcastRank), int64(0)).
+		Return([]domain.LeaderboardEntry{
+			{UserID: "user-1", Score: 1000, Rank: 1},
+			{UserID: "user-2", Score: 500, Rank: 2},
+		}, int64(2), nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1", "user-2"}).
+		Return(map[string]string{"user-1": "alice", "user-2": "bob"}, nil).
+		Times(1)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(2), total)
+	require.Len(t, entries, 2)
+	require.Equal(t, "user-1", entries[0].UserID)
+}
+
+func TestLeaderboardUseCase_GetLeaderboard_WhenBackfillFails_ShouldNotMarkLoaded(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{}, int64(0), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		UpdateScore(ctx, "user-1", int64(1000)).
+		Return(errors.New("redis unavailable")).
+		Times(1)
+	mockCacheRepo.EXPECT().MarkLoaded(gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetLeaderboard(ctx, int64(domain.MaxBroadcastRank), int64(0)).
+		Return([]domain.LeaderboardEntry{{UserID: "user-1", Score: 1000, Rank: 1}}, int64(1), nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1"}).
+		Return(map[string]string{"user-1": "alice"}, nil).
+		Times(1)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(1), total)
+	require.Len(t, entries, 1)
+}
+
 func TestLeaderboardUseCase_GetLeaderboard_WhenCacheMissAndPersistenceFails_ShouldReturnError(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	ctx := context.Background()
@@ -271,7 +582,7 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheMissAndPersistenceFails_Shou
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
@@ -308,6 +619,11 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheMiss_ShouldLoadMaxBroadcastR
 		Return(nil).
 		Times(1)
 
+	mockCacheRepo.EXPECT().
+		MarkLoaded(ctx).
+		Return(nil).
+		Times(1)
+
 	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
 	// Load up to MaxBroadcastRank entries
 	mockPersistenceRepo.EXPECT().
@@ -328,7 +644,7 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheMiss_ShouldLoadMaxBroadcastR
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
@@ -356,6 +672,11 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheMissAndPersistenceEmpty_Shou
 		Times(1)
 	// No backfill needed - persistence is empty
 
+	mockCacheRepo.EXPECT().
+		MarkLoaded(ctx).
+		Return(nil).
+		Times(1)
+
 	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
 	mockPersistenceRepo.EXPECT().
 		GetLeaderboard(ctx, int64(domain.MaxBroadcastRank), int64(0)).
@@ -366,7 +687,7 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheMissAndPersistenceEmpty_Shou
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
 	entries, total, err := uc.GetLeaderboard(ctx, 10, 0)
@@ -378,32 +699,1070 @@ func TestLeaderboardUseCase_GetLeaderboard_WhenCacheMissAndPersistenceEmpty_Shou
 	require.Equal(t, int64(0), total)
 }
 
-func TestLeaderboardUseCase_SubscribeToEntryUpdates_ShouldReturnChannelFromBroadcastService(t *testing.T) {
+func TestLeaderboardUseCase_GetUserRank_WhenUserRanked_ShouldReturnEnrichedEntry(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	expectedCh := make(chan *domain.LeaderboardEntry, 1)
-
 	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
-	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
-	mockUserRepo := mocks.NewMockUserRepository(ctrl)
-
-	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
-	mockBroadcastService.EXPECT().
-		SubscribeToEntryUpdates(ctx).
-		Return((<-chan *domain.LeaderboardEntry)(expectedCh), nil).
+	mockCacheRepo.EXPECT().
+		GetUserEntry(ctx, "user-7").
+		Return(&domain.LeaderboardEntry{UserID: "user-7", Score: 300, Rank: 7}, nil).
 		Times(1)
 
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-7"}).
+		Return(map[string]string{"user-7": "grace"}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entry, err := uc.GetUserRank(ctx, "user-7")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	require.Equal(t, int64(7), entry.Rank)
+	require.Equal(t, "grace", entry.Username)
+}
+
+func TestLeaderboardUseCase_GetUserRank_WhenUserNotRanked_ShouldReturnNil(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntry(ctx, "user-7").
+		Return(nil, nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().GetByIDs(gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entry, err := uc.GetUserRank(ctx, "user-7")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Nil(t, entry)
+}
+
+func TestLeaderboardUseCase_GetUserRank_WhenCacheFails_ShouldFallBackToPersistence(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntry(gomock.Any(), "user-7").
+		Return(nil, errors.New("redis unavailable")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetUserEntry(gomock.Any(), "user-7").
+		Return(&domain.LeaderboardEntry{UserID: "user-7", Score: 300, Rank: 3}, nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(gomock.Any(), []string{"user-7"}).
+		Return(map[string]string{"user-7": "grace"}, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entry, err := uc.GetUserRank(ctx, "user-7")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.LeaderboardEntry{UserID: "user-7", Username: "grace", Score: 300, Rank: 3}, entry)
+}
+
+func TestLeaderboardUseCase_GetUserRank_WhenCacheAndPersistenceFail_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntry(gomock.Any(), "user-7").
+		Return(nil, errors.New("redis unavailable")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetUserEntry(gomock.Any(), "user-7").
+		Return(nil, errors.New("connection refused")).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().GetByIDs(gomock.Any(), gomock.Any()).Times(0)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entry, err := uc.GetUserRank(ctx, "user-7")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Nil(t, entry)
+}
+
+func TestLeaderboardUseCase_GetGapToNext_WhenMidBoard_ShouldReturnGapToPlayerAbove(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntry(ctx, "user-7").
+		Return(&domain.LeaderboardEntry{UserID: "user-7", Score: 300, Rank: 7}, nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(1), int64(5)).
+		Return([]domain.LeaderboardEntry{{UserID: "user-6", Score: 340, Rank: 6}}, int64(10), nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	gap, err := uc.GetGapToNext(ctx, "user-7")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, domain.GapInfo{UserID: "user-7", Score: 300, Rank: 7, ScoreGap: 40}, gap)
+}
+
+func TestLeaderboardUseCase_GetGapToNext_WhenLeader_ShouldReturnLeaderWithoutLookingAbove(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntry(ctx, "user-1").
+		Return(&domain.LeaderboardEntry{UserID: "user-1", Score: 900, Rank: 1}, nil).
+		Times(1)
+	mockCacheRepo.EXPECT().GetLeaderboard(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	gap, err := uc.GetGapToNext(ctx, "user-1")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, domain.GapInfo{UserID: "user-1", Score: 900, Rank: 1, IsLeader: true}, gap)
+}
+
+func TestLeaderboardUseCase_GetGapToNext_WhenUserNotRanked_ShouldReturnNotInLeaderboard(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntry(ctx, "user-7").
+		Return(nil, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := uc.GetGapToNext(ctx, "user-7")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrUserNotInLeaderboard)
+}
+
+func TestLeaderboardUseCase_GetStanding_WhenMidBoard_ShouldSplitEnrichedNeighborsAroundUser(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntry(ctx, "user-7").
+		Return(&domain.LeaderboardEntry{UserID: "user-7", Score: 300, Rank: 7}, nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(5), int64(4)).
+		Return([]domain.LeaderboardEntry{
+			{UserID: "user-5", Score: 500, Rank: 5},
+			{UserID: "user-6", Score: 400, Rank: 6},
+			{UserID: "user-7", Score: 300, Rank: 7},
+			{UserID: "user-8", Score: 200, Rank: 8},
+			{UserID: "user-9", Score: 100, Rank: 9},
+		}, int64(20), nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, gomock.Any()).
+		Return(map[string]string{"user-5": "eve", "user-6": "frank", "user-7": "grace", "user-8": "heidi", "user-9": "ivan"}, nil).
+		Times(1)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	standing, err := uc.GetStanding(ctx, "user-7", 2)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.True(t, standing.InLeaderboard)
+	require.Equal(t, &domain.LeaderboardEntry{UserID: "user-7", Username: "grace", Score: 300, Rank: 7}, standing.Self)
+	require.Equal(t, []domain.LeaderboardEntry{
+		{UserID: "user-5", Username: "eve", Score: 500, Rank: 5},
+		{UserID: "user-6", Username: "frank", Score: 400, Rank: 6},
+	}, standing.Above)
+	require.Equal(t, []domain.LeaderboardEntry{
+		{UserID: "user-8", Username: "heidi", Score: 200, Rank: 8},
+		{UserID: "user-9", Username: "ivan", Score: 100, Rank: 9},
+	}, standing.Below)
+}
+
+func TestLeaderboardUseCase_GetStanding_WhenUserNotRanked_ShouldReturnEmptyStanding(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntry(ctx, "user-7").
+		Return(nil, nil).
+		Times(1)
+	mockCacheRepo.EXPECT().GetLeaderboard(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	standing, err := uc.GetStanding(ctx, "user-7", 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.False(t, standing.InLeaderboard)
+	require.Nil(t, standing.Self)
+	require.Empty(t, standing.Above)
+	require.Empty(t, standing.Below)
+}
+
+func TestLeaderboardUseCase_GetTotalPlayers_WhenCacheHit_ShouldReturnCacheCount(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetTotalPlayers(ctx).
+		Return(int64(42), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().GetTotalPlayers(gomock.Any()).Times(0)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.GetTotalPlayers(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(42), total)
+}
+
+func TestLeaderboardUseCase_GetTotalPlayers_WhenCacheNotLoaded_ShouldCountFromPersistence(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetTotalPlayers(ctx).
+		Return(int64(1), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(false, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetTotalPlayers(ctx).
+		Return(int64(42), nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.GetTotalPlayers(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(42), total)
+}
+
+func TestLeaderboardUseCase_GetTotalPlayers_WhenCacheError_ShouldFallBackToPersistence(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetTotalPlayers(ctx).
+		Return(int64(0), errors.New("redis error")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetTotalPlayers(ctx).
+		Return(int64(40), nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.GetTotalPlayers(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(40), total)
+}
+
+func TestLeaderboardUseCase_GetTotalPlayers_WhenCacheAndPersistenceFail_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetTotalPlayers(ctx).
+		Return(int64(0), errors.New("redis error")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetTotalPlayers(ctx).
+		Return(int64(0), errors.New("db error")).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.GetTotalPlayers(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Zero(t, total)
+	require.Contains(t, err.Error(), "failed to retrieve total players")
+}
+
+func TestLeaderboardUseCase_SubscribeToStreamUpdates_ShouldReturnChannelFromBroadcastService(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedCh := make(chan domain.StreamUpdate, 1)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		SubscribeToStreamUpdates(ctx).
+		Return((<-chan domain.StreamUpdate)(expectedCh), nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	ch, err := uc.SubscribeToStreamUpdates(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.NotNil(t, ch)
+	// Channel comparison is not reliable, just verify it's not nil
+}
+
+func TestLeaderboardUseCase_GetTotalPlayers_WhenRepositoriesExceedQueryTimeout_ShouldReturnDeadlineExceeded(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetTotalPlayers(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) (int64, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetTotalPlayers(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) (int64, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 10*time.Millisecond, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.GetTotalPlayers(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Zero(t, total)
+}
+
+func TestLeaderboardUseCase_GetUserRanks_WhenMiddleUserAbsent_ShouldPreserveOrderAndFlagMissing(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userIDs := []string{"user-3", "user-404", "user-1"}
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntries(ctx, userIDs).
+		Return(map[string]domain.LeaderboardEntry{
+			"user-1": {UserID: "user-1", Score: 1500, Rank: 1},
+			"user-3": {UserID: "user-3", Score: 900, Rank: 3},
+		}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, userIDs).
+		Return(map[string]string{"user-1": "alice", "user-3": "carol", "user-404": "dave"}, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, err := uc.GetUserRanks(ctx, userIDs)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	require.Equal(t, "user-3", entries[0].UserID)
+	require.True(t, entries[0].InLeaderboard)
+	require.Equal(t, int64(3), entries[0].Rank)
+	require.Equal(t, "carol", entries[0].Username)
+
+	require.Equal(t, "user-404", entries[1].UserID)
+	require.False(t, entries[1].InLeaderboard)
+	require.Zero(t, entries[1].Rank)
+	require.Zero(t, entries[1].Score)
+	require.Equal(t, "dave", entries[1].Username)
+
+	require.Equal(t, "user-1", entries[2].UserID)
+	require.True(t, entries[2].InLeaderboard)
+	require.Equal(t, int64(1), entries[2].Rank)
+}
+
+func TestLeaderboardUseCase_GetUsernames_WhenSomeUsersUnknown_ShouldReturnOnlyKnownUsernames(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userIDs := []string{"user-1", "user-404", "user-3"}
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, userIDs).
+		Return(map[string]string{"user-1": "alice", "user-3": "carol"}, nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(nil, nil, mockUserRepo, nil, nil, 0, UsernameConfig{Fallback: "Player {id}"}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	usernames, err := uc.GetUsernames(ctx, userIDs)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"user-1": "alice", "user-3": "carol"}, usernames)
+}
+
+func TestLeaderboardUseCase_GetUserRanks_WhenCacheFails_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetUserEntries(ctx, []string{"user-1"}).
+		Return(nil, errors.New("redis error")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, err := uc.GetUserRanks(ctx, []string{"user-1"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Nil(t, entries)
+	require.Contains(t, err.Error(), "failed to retrieve user ranks")
+}
+
+func TestLeaderboardUseCase_WaitForVersionChange_WhenVersionAlreadyChanged_ShouldReturnAtOnce(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().GetVersion(ctx).Return(int64(8), nil).Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	version, err := uc.WaitForVersionChange(ctx, 7, time.Minute)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(8), version)
+}
+
+func TestLeaderboardUseCase_WaitForVersionChange_WhenNoChangeBeforeTimeout_ShouldReturnSince(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().GetVersion(gomock.Any()).Return(int64(7), nil).MinTimes(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	start := time.Now()
+	version, err := uc.WaitForVersionChange(ctx, 7, 30*time.Millisecond)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(7), version)
+	require.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+}
+
+func TestLeaderboardUseCase_GetBroadcastStatus_WhenPublishedBefore_ShouldReportLag(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	lastPublish := time.Now().Add(-30 * time.Second)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().LastEntryPublishAt().Return(lastPublish).Times(1)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	status := uc.GetBroadcastStatus(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, lastPublish, status.LastPublishAt)
+	require.GreaterOrEqual(t, status.LagSeconds, 30.0)
+}
+
+func TestLeaderboardUseCase_GetBroadcastStatus_WhenNeverPublished_ShouldReturnZeroStatus(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().LastEntryPublishAt().Return(time.Time{}).Times(1)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+
 	logger := logger.New("info", false)
-	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
 
 	// ── Act ─────────────────────────────────────────────────────────────
-	ch, err := uc.SubscribeToEntryUpdates(ctx)
+	status := uc.GetBroadcastStatus(ctx)
 
 	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, domain.BroadcastStatus{}, status)
+}
+
+func TestLeaderboardUseCase_SubscribeToStreamUpdates_WhenPresenceEnabled_ShouldJoinAndLeaveAsViewer(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx, cancel := context.WithCancel(context.Background())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updates := make(chan domain.StreamUpdate)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		SubscribeToStreamUpdates(ctx).
+		Return((<-chan domain.StreamUpdate)(updates), nil).
+		Times(1)
+
+	var connectionID string
+	joined := make(chan struct{})
+	left := make(chan struct{})
+	mockPresence := mocks.NewMockViewerPresenceRepository(ctrl)
+	mockPresence.EXPECT().
+		Touch(gomock.Any(), gomock.Any(), domain.ViewerPresenceTTL).
+		DoAndReturn(func(_ context.Context, id string, _ time.Duration) (int64, error) {
+			connectionID = id
+			return 1, nil
+		}).
+		Times(1)
+	mockBroadcastService.EXPECT().
+		BroadcastViewerCount(gomock.Any(), int64(1)).
+		Do(func(context.Context, int64) { close(joined) }).
+		Return(nil).
+		Times(1)
+	mockPresence.EXPECT().
+		Leave(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(leaveCtx context.Context, id string) (int64, error) {
+			require.Equal(t, connectionID, id)
+			require.NoError(t, leaveCtx.Err())
+			return 0, nil
+		}).
+		Times(1)
+	mockBroadcastService.EXPECT().
+		BroadcastViewerCount(gomock.Any(), int64(0)).
+		Do(func(context.Context, int64) { close(left) }).
+		Return(nil).
+		Times(1)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, mockPresence, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	ch, err := uc.SubscribeToStreamUpdates(ctx)
 	require.NoError(t, err)
 	require.NotNil(t, ch)
-	// Channel comparison is not reliable, just verify it's not nil
+	<-joined
+	cancel()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	select {
+	case <-left:
+	case <-time.After(time.Second):
+		t.Fatal("viewer presence was not removed after the subscriber disconnected")
+	}
+}
+
+func TestLeaderboardUseCase_SubscribeToStreamUpdates_WhenHeartbeatCountUnchanged_ShouldPublishOnlyChanges(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updates := make(chan domain.StreamUpdate)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		SubscribeToStreamUpdates(ctx).
+		Return((<-chan domain.StreamUpdate)(updates), nil).
+		Times(1)
+
+	// Join sees 2 viewers, two heartbeats still see 2, the third sees 3
+	counts := []int64{2, 2, 2, 3}
+	touches := 0
+	mockPresence := mocks.NewMockViewerPresenceRepository(ctrl)
+	mockPresence.EXPECT().
+		Touch(gomock.Any(), gomock.Any(), domain.ViewerPresenceTTL).
+		DoAndReturn(func(context.Context, string, time.Duration) (int64, error) {
+			count := counts[min(touches, len(counts)-1)]
+			touches++
+			return count, nil
+		}).
+		MinTimes(len(counts))
+	mockPresence.EXPECT().Leave(gomock.Any(), gomock.Any()).Return(int64(2), nil).AnyTimes()
+
+	published := make(chan int64, 10)
+	mockBroadcastService.EXPECT().
+		BroadcastViewerCount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, count int64) error {
+			published <- count
+			return nil
+		}).
+		AnyTimes()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, mockPresence, 0, UsernameConfig{}, logger)
+	uc.viewerHeartbeat = 5 * time.Millisecond
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := uc.SubscribeToStreamUpdates(ctx)
+	require.NoError(t, err)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	for _, want := range []int64{2, 3} {
+		select {
+		case got := <-published:
+			require.Equal(t, want, got)
+		case <-time.After(time.Second):
+			t.Fatalf("viewer count %d was not published", want)
+		}
+	}
+}
+
+func TestLeaderboardUseCase_GetViewerCount_WhenPresenceDisabled_ShouldReturnZero(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	count, err := uc.GetViewerCount(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestLeaderboardUseCase_GetScoreHistogram_WhenKnownDistribution_ShouldReturnBucketCounts(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedBuckets := []domain.ScoreBucket{
+		{Min: 0, Max: 24},
+		{Min: 25, Max: 49},
+		{Min: 50, Max: 74},
+		{Min: 75, Max: 99},
+	}
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetScoreBounds(ctx).
+		Return(int64(0), int64(99), true, nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		CountScores(ctx, expectedBuckets).
+		Return([]int64{5, 3, 0, 2}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	histogram, err := uc.GetScoreHistogram(ctx, 4)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, []domain.ScoreBucket{
+		{Min: 0, Max: 24, Count: 5},
+		{Min: 25, Max: 49, Count: 3},
+		{Min: 50, Max: 74, Count: 0},
+		{Min: 75, Max: 99, Count: 2},
+	}, histogram)
+}
+
+func TestLeaderboardUseCase_GetScoreHistogram_WhenSingleDistinctScore_ShouldReturnOneBucket(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetScoreBounds(ctx).
+		Return(int64(500), int64(500), true, nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		CountScores(ctx, []domain.ScoreBucket{{Min: 500, Max: 500}}).
+		Return([]int64{3}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	histogram, err := uc.GetScoreHistogram(ctx, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, []domain.ScoreBucket{{Min: 500, Max: 500, Count: 3}}, histogram)
+}
+
+func TestLeaderboardUseCase_GetScoreHistogram_WhenBoardEmpty_ShouldReturnNoBuckets(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetScoreBounds(ctx).
+		Return(int64(0), int64(0), false, nil).
+		Times(1)
+	mockCacheRepo.EXPECT().CountScores(gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	histogram, err := uc.GetScoreHistogram(ctx, 10)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Empty(t, histogram)
+	require.NotNil(t, histogram)
+}
+
+func TestLeaderboardUseCase_GetLeaderboard_WhenUsernameLookupFails_ShouldUseConfiguredFallback(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{
+			{UserID: "0f8fad5b-d9cb-469f-a165-70867728950e", Score: 1000, Rank: 1},
+		}, int64(1), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, gomock.Any()).
+		Return(nil, errors.New("database error")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{Fallback: "Player {id}"}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, _, err := uc.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "Player 0f8fad5b", entries[0].Username)
+}
+
+func TestLeaderboardUseCase_GetLeaderboard_WhenUsernameLookupFailsWithFallbackUnset_ShouldLeaveUsernamesEmpty(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{{UserID: "user-1", Score: 1000, Rank: 1}}, int64(1), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1"}).
+		Return(nil, errors.New("database error")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, _, err := uc.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Empty(t, entries[0].Username)
+}
+
+func TestLeaderboardUseCase_GetLeaderboard_WhenUsernameLookupFailsAndUsernamesRequired_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		GetLeaderboard(ctx, int64(10), int64(0)).
+		Return([]domain.LeaderboardEntry{{UserID: "user-1", Score: 1000, Rank: 1}}, int64(1), nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		IsLoaded(ctx).
+		Return(true, nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1"}).
+		Return(nil, errors.New("database error")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewLeaderboardUseCase(mockCacheRepo, mockPersistenceRepo, mockUserRepo, mockBroadcastService, nil, 0, UsernameConfig{Fallback: "Unknown", Required: true}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, _, err := uc.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to retrieve usernames")
+	require.Nil(t, entries)
 }
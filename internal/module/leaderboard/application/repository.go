@@ -1,9 +1,10 @@
 package application
 
-//go:generate mockgen -destination=../infrastructure/mocks/repository_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application UserRepository,LeaderboardPersistenceRepository,LeaderboardCacheRepository
+//go:generate mockgen -destination=../infrastructure/mocks/repository_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application UserRepository,LeaderboardPersistenceRepository,LeaderboardCacheRepository,ScoreAuditRepository,SeasonRepository
 
 import (
 	"context"
+	"time"
 
 	"real-time-leaderboard/internal/module/leaderboard/domain"
 )
@@ -13,18 +14,101 @@ import (
 type UserRepository interface {
 	GetByIDs(ctx context.Context, userIDs []string) (map[string]string, error)
 	// Returns map[userID]username for efficient batch fetching
+	IsEmailVerified(ctx context.Context, userID string) (bool, error)
 }
 
 // LeaderboardPersistenceRepository defines the interface for persistent leaderboard storage in PostgreSQL
 // This stores the highest score per user as persistent storage
 type LeaderboardPersistenceRepository interface {
+	// UpsertScore stores the score unless the user already has a better one (higher for desc, lower for asc)
 	UpsertScore(ctx context.Context, userID string, score int64) error
+	// SetScore stores the score, replacing the user's existing score even when it is better
+	SetScore(ctx context.Context, userID string, score int64) error
+	// IncrementScore atomically adds delta to the user's score (starting from 0) and returns the new total
+	IncrementScore(ctx context.Context, userID string, delta int64) (int64, error)
+	// GetScore returns the user's score, whether or not it is on the board; found is false when they have none
+	GetScore(ctx context.Context, userID string) (score int64, found bool, err error)
 	GetLeaderboard(ctx context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error)
+	GetTotalPlayers(ctx context.Context) (int64, error)
+	// GetUserEntry returns the user's score and rank, counting only strictly better scores, or nil if the user is not on the board
+	GetUserEntry(ctx context.Context, userID string) (*domain.LeaderboardEntry, error)
+	// GetUpdatedTimes returns when each listed user's score last changed, keyed by user ID; users without a score are omitted
+	GetUpdatedTimes(ctx context.Context, userIDs []string) (map[string]time.Time, error)
+	// DeleteScore removes the user's score; deleting a score that does not exist is not an error
+	DeleteScore(ctx context.Context, userID string) error
 }
 
 // LeaderboardCacheRepository defines the interface for leaderboard cache operations in Redis
 type LeaderboardCacheRepository interface {
 	UpdateScore(ctx context.Context, userID string, score int64) error
+	// SubmitAndRank atomically keeps the better of the user's best and the new score,
+	// then returns the user's rank and whether the submission took rank 1.
+	// When the cache does not hold the user, a nil seed fails with domain.ErrScoreNotCached and a non-nil
+	// seed is restored first, so the user's persisted best is not lost. Every write fails with
+	// domain.ErrLeaderboardNotLoaded, changing nothing, while the board is not loaded (see IsLoaded).
+	SubmitAndRank(ctx context.Context, userID string, score int64, seed *domain.ScoreSeed) (*domain.ScoreSubmission, error)
+	// SetAndRank atomically overwrites the user's score whether or not it beats their best,
+	// then returns the user's rank and whether the write took rank 1; the loaded check applies as in SubmitAndRank
+	SetAndRank(ctx context.Context, userID string, score int64) (*domain.ScoreSubmission, error)
+	// IncrementAndRank atomically adds delta to the user's score (starting from 0) when the new total stays
+	// within [minScore, maxScore], then returns the total, the user's rank and whether it took rank 1.
+	// seed and the loaded check apply as in SubmitAndRank.
+	IncrementAndRank(ctx context.Context, userID string, delta, minScore, maxScore int64, seed *domain.ScoreSeed) (*domain.ScoreIncrement, error)
 	GetLeaderboard(ctx context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error)
 	GetUserRank(ctx context.Context, userID string) (int64, error)
+	GetTotalPlayers(ctx context.Context) (int64, error)
+	// GetUserEntry returns the user's rank and score, or nil if the user is not in the leaderboard
+	GetUserEntry(ctx context.Context, userID string) (*domain.LeaderboardEntry, error)
+	// GetUserEntries returns the rank and score of each listed user that is in the leaderboard, keyed by user ID
+	GetUserEntries(ctx context.Context, userIDs []string) (map[string]domain.LeaderboardEntry, error)
+	// GetScoreBounds returns the lowest and highest score on the board; ok is false when the board is empty
+	GetScoreBounds(ctx context.Context) (lowest, highest int64, ok bool, err error)
+	// CountScores returns the number of players scoring within each bucket's [Min, Max], in bucket order
+	CountScores(ctx context.Context, buckets []domain.ScoreBucket) ([]int64, error)
+	// GetVersion returns a counter that changes whenever a score on the board changes
+	GetVersion(ctx context.Context) (int64, error)
+	// GetUpdatedAt returns when the version last changed; the zero time before the first change
+	GetUpdatedAt(ctx context.Context) (time.Time, error)
+	TouchActivity(ctx context.Context, userID string, at time.Time) error
+	// RemoveInactiveUsers atomically removes users last active before the given time and returns their IDs
+	RemoveInactiveUsers(ctx context.Context, before time.Time) ([]string, error)
+	// IsLoaded reports whether the board was loaded from persistence since it last expired or was reset
+	IsLoaded(ctx context.Context) (bool, error)
+	// MarkLoaded records that the board was loaded from persistence; the mark expires with the board
+	MarkLoaded(ctx context.Context) error
+	// Reset empties the board and its activity records, bumping the version so pollers refetch
+	Reset(ctx context.Context) error
+	// RemoveUser atomically drops the user's score, kept best and activity, bumping the version if they were ranked
+	RemoveUser(ctx context.Context, userID string) error
+}
+
+// ScoreAuditRepository defines the interface for the append-only score submission audit log
+type ScoreAuditRepository interface {
+	Record(ctx context.Context, entry *domain.ScoreAuditEntry) error
+	// List returns entries newest first; an empty userID lists all users
+	List(ctx context.Context, userID string, limit, offset int64) ([]domain.ScoreAuditEntry, int64, error)
+}
+
+// SeasonRepository defines the interface for season metadata and archived season standings
+type SeasonRepository interface {
+	// GetActive returns the season that has not ended yet, or nil if there is none
+	GetActive(ctx context.Context) (*domain.Season, error)
+	// Create records a new active season, returning ErrSeasonAlreadyActive if one is already open
+	Create(ctx context.Context, season *domain.Season) error
+	// Archive atomically copies the persisted board into the season's standings, marks the season ended
+	// at endedAt and empties the persisted board. It returns ErrNoActiveSeason if the season already ended.
+	Archive(ctx context.Context, seasonID string, endedAt time.Time) error
+	// List returns seasons newest first
+	List(ctx context.Context, limit, offset int64) ([]domain.Season, int64, error)
+	// GetBestRank returns the user's best archived rank, earliest season first on ties, or nil if the user has none
+	GetBestRank(ctx context.Context, userID string) (*domain.BestRank, error)
+	// GetRankHistory returns one point per season that ended within [start, end], oldest first,
+	// with Ranked false for seasons the user has no standing in
+	GetRankHistory(ctx context.Context, userID string, start, end time.Time) ([]domain.RankPoint, error)
+	// GetLatestEnded returns the most recently ended season, or nil if none has ended
+	GetLatestEnded(ctx context.Context) (*domain.Season, error)
+	// GetEndedNearest returns the season that ended before before with its end closest to at, or nil if there is none
+	GetEndedNearest(ctx context.Context, at, before time.Time) (*domain.Season, error)
+	// GetStandings returns the archived standings of a season, best rank first
+	GetStandings(ctx context.Context, seasonID string) ([]domain.SeasonStanding, error)
 }
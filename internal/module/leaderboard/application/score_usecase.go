@@ -3,9 +3,13 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/database"
 	"real-time-leaderboard/internal/shared/logger"
 )
 
@@ -14,6 +18,12 @@ import (
 // ScoreUseCase defines the interface for score operations
 type ScoreUseCase interface {
 	SubmitScore(ctx context.Context, userID string, req SubmitScoreRequest) error
+	ValidateScore(ctx context.Context, req SubmitScoreRequest) error
+	IncrementScore(ctx context.Context, userID string, delta int64) (int64, error)
+	ResetScore(ctx context.Context, userID string) error
+	SetScore(ctx context.Context, userID string, score int64) error
+	// ShadowRejections returns how many submissions have been accepted in shadow mode since startup
+	ShadowRejections() int64
 }
 
 // scoreUseCase implements ScoreUseCase interface
@@ -22,10 +32,45 @@ type scoreUseCase struct {
 	cacheRepo        LeaderboardCacheRepository
 	userRepo         UserRepository
 	broadcastService BroadcastService
+	leaderNotifier   LeaderNotifier
+	auditRepo        ScoreAuditRepository
+	quotaRepo        SubmissionQuotaRepository
+	config           ScoreConfig
+	shadowRejections atomic.Int64
+	now              func() time.Time
 	logger           *logger.Logger
 }
 
-// NewScoreUseCase creates a new score use case
+// ScoreConfig holds optional score submission behavior (zero value keeps defaults)
+type ScoreConfig struct {
+	// TrackActivity records each user's latest submission time so inactive players can be evicted
+	TrackActivity bool
+	// RequireVerifiedEmail rejects submissions from users who have not verified their email
+	RequireVerifiedEmail bool
+	// QueryTimeout bounds the repository calls of a submission and of its audit write (0 disables)
+	QueryTimeout time.Duration
+	// MaxScore rejects submissions above it; 0 or anything above domain.MaxSafeScore uses domain.MaxSafeScore
+	MaxScore int64
+	// MinScore is the floor increments cannot take a total below; anything below -domain.MaxSafeScore uses -domain.MaxSafeScore
+	MinScore int64
+	// Usernames decides what broadcast entries show when their username cannot be loaded
+	Usernames UsernameConfig
+	// DisableBroadcast stores scores without publishing entry updates to viewers; new-leader notifications still go out
+	DisableBroadcast bool
+	// AllowZeroScore accepts submissions of 0 (or with no score) for games where 0 is a legitimate result
+	AllowZeroScore bool
+	// DailySubmissionQuota caps each user's score submissions and increments per UTC day (0 disables; needs a quota repository)
+	DailySubmissionQuota int64
+	// AllowScoreReset lets users delete their own score, e.g. after practice rounds
+	AllowScoreReset bool
+	// ShadowMaxScore accepts submissions above MaxScore instead of rejecting them, auditing and counting each one
+	// as a would-have-been rejection, so a new bound can be tried out before it is enforced
+	ShadowMaxScore bool
+}
+
+// NewScoreUseCase creates a new score use case.
+// leaderNotifier, auditRepo and quotaRepo may be nil to disable new-leader notifications, auditing and
+// the daily submission quota.
 //
 //nolint:revive // unexported-return: intentional design - accept interface, return struct
 func NewScoreUseCase(
@@ -33,6 +78,10 @@ func NewScoreUseCase(
 	cacheRepo LeaderboardCacheRepository,
 	userRepo UserRepository,
 	broadcastService BroadcastService,
+	leaderNotifier LeaderNotifier,
+	auditRepo ScoreAuditRepository,
+	quotaRepo SubmissionQuotaRepository,
+	cfg ScoreConfig,
 	l *logger.Logger,
 ) *scoreUseCase {
 	return &scoreUseCase{
@@ -40,69 +89,474 @@ func NewScoreUseCase(
 		cacheRepo:        cacheRepo,
 		userRepo:         userRepo,
 		broadcastService: broadcastService,
+		leaderNotifier:   leaderNotifier,
+		auditRepo:        auditRepo,
+		quotaRepo:        quotaRepo,
+		config:           cfg,
+		now:              time.Now,
 		logger:           l,
 	}
 }
 
 // SubmitScoreRequest represents a score submission request
 type SubmitScoreRequest struct {
-	Score int64 `json:"score" validate:"required,gte=0" example:"1000"`
+	// Score has no "required" rule, which would reject 0; whether 0 is accepted is decided by ScoreConfig.AllowZeroScore
+	Score int64 `json:"score" validate:"gte=0" example:"1000"`
 }
 
-// SubmitScore upserts the score for a user using write-through: updates cache first, then persistence.
-// Both must succeed for a successful response. Broadcast is best-effort after both succeed.
+// SetScoreRequest represents an admin overwrite of a user's score; Score is a pointer so 0 can be set
+type SetScoreRequest struct {
+	Score *int64 `json:"score" validate:"required" example:"1000"`
+}
+
+// IncrementScoreRequest represents a score increment request
+type IncrementScoreRequest struct {
+	Delta int64 `json:"delta" validate:"required" example:"50"`
+}
+
+// SubmitScore keeps the user's best score using write-through: updates cache first, then persistence.
+// A score that does not beat the user's best leaves both unchanged. Both must succeed for a successful response. Broadcast and new-leader notification are best-effort after both succeed.
+// Every attempt, accepted or rejected, is recorded in the audit log.
 func (uc *scoreUseCase) SubmitScore(ctx context.Context, userID string, req SubmitScoreRequest) error {
-	if err := uc.cacheRepo.UpdateScore(ctx, userID, req.Score); err != nil {
+	shadowErr, err := uc.checkScore(req)
+	if err == nil {
+		err = uc.submitScore(ctx, userID, req)
+	}
+	if err == nil && shadowErr != nil {
+		uc.reportShadowRejection(ctx, req.Score, shadowErr)
+	}
+	var reason string
+	if shadowErr != nil {
+		reason = domain.ShadowReasonPrefix + shadowErr.Error()
+	}
+	uc.recordAudit(ctx, userID, req.Score, nil, reason, err)
+	return err
+}
+
+// ValidateScore runs the score checks of SubmitScore without touching the leaderboard, the persistence
+// or the audit log, returning the error SubmitScore would reject the score with
+func (uc *scoreUseCase) ValidateScore(_ context.Context, req SubmitScoreRequest) error {
+	_, err := uc.checkScore(req)
+	return err
+}
+
+// checkScore rejects scores outside the accepted bounds; shared by SubmitScore and ValidateScore.
+// With ShadowMaxScore a score above MaxScore passes, and the rejection it would have got is returned as shadowErr;
+// scores beyond domain.MaxSafeScore are always rejected since Redis cannot store them exactly.
+func (uc *scoreUseCase) checkScore(req SubmitScoreRequest) (shadowErr, err error) {
+	if req.Score == 0 && !uc.config.AllowZeroScore {
+		return nil, domain.ErrZeroScoreNotAllowed
+	}
+	if maxScore := uc.maxScore(); req.Score > maxScore {
+		err := fmt.Errorf("%w: %d", domain.ErrScoreTooHigh, maxScore)
+		if uc.config.ShadowMaxScore && req.Score <= domain.MaxSafeScore {
+			return err, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// minScore returns the configured increment floor, capped to the range Redis stores exactly
+func (uc *scoreUseCase) minScore() int64 {
+	return max(uc.config.MinScore, -domain.MaxSafeScore)
+}
+
+// maxScore returns the configured score upper bound, capped to the range Redis stores exactly
+func (uc *scoreUseCase) maxScore() int64 {
+	if uc.config.MaxScore <= 0 || uc.config.MaxScore > domain.MaxSafeScore {
+		return domain.MaxSafeScore
+	}
+	return uc.config.MaxScore
+}
+
+// submitScore stores a score that already passed checkScore
+func (uc *scoreUseCase) submitScore(ctx context.Context, userID string, req SubmitScoreRequest) error {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	if err := uc.checkEmailVerified(ctx, userID); err != nil {
+		return err
+	}
+
+	now := uc.now()
+	if err := uc.checkSubmissionQuota(ctx, userID, now); err != nil {
+		return err
+	}
+
+	// Keep the better of the user's best and this score, and read the new rank, in one atomic call
+	var submission *domain.ScoreSubmission
+	err := uc.writeCache(ctx, userID, func(seed *domain.ScoreSeed) error {
+		var err error
+		submission, err = uc.cacheRepo.SubmitAndRank(ctx, userID, req.Score, seed)
+		return err
+	})
+	if err != nil {
 		uc.logger.Errorf(ctx, "Failed to update cache: %v", err)
+		uc.releaseSubmissionQuota(ctx, userID, now)
 		return fmt.Errorf("failed to update score: %w", err)
 	}
+
+	if uc.config.TrackActivity {
+		if err := uc.cacheRepo.TouchActivity(ctx, userID, now); err != nil {
+			uc.logger.Warnf(ctx, "Failed to record user activity: %v", err)
+		}
+	}
+
+	if !submission.Improved {
+		uc.logger.Infof(ctx, "Score not improved: user=%s, score=%d, rank=%d (keeping best score)", userID, req.Score, submission.Rank)
+		return nil
+	}
+
 	if err := uc.persistenceRepo.UpsertScore(ctx, userID, req.Score); err != nil {
 		uc.logger.Errorf(ctx, "Failed to upsert score: %v", err)
+		uc.releaseSubmissionQuota(ctx, userID, now)
 		return fmt.Errorf("failed to update score: %w", err)
 	}
 
-	// Get user's rank after update
-	rank, err := uc.cacheRepo.GetUserRank(ctx, userID)
+	uc.publishEntry(ctx, userID, req.Score, submission.Rank, submission.IsNewLeader)
+	return nil
+}
+
+// IncrementScore adds delta to the user's score using write-through: updates cache first, then persistence.
+// A total that would leave [MinScore, MaxScore] is rejected and leaves both unchanged. Both must succeed for a
+// successful response; if persistence fails the cache increment is reverted. Returns the new persisted total;
+// should the cached total differ from it, the cache is corrected to match.
+// Increments count against the daily submission quota like submissions; rejected or failed ones are released.
+// Every attempt, accepted or rejected, is recorded in the audit log with its delta.
+func (uc *scoreUseCase) IncrementScore(ctx context.Context, userID string, delta int64) (int64, error) {
+	total, err := uc.incrementScore(ctx, userID, delta)
+	uc.recordAudit(ctx, userID, total, &delta, "", err)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// incrementScore applies an increment for IncrementScore. On a score bounds rejection it still returns the
+// total the increment would have reached, for the audit log.
+func (uc *scoreUseCase) incrementScore(ctx context.Context, userID string, delta int64) (int64, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	if err := uc.checkEmailVerified(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	now := uc.now()
+	if err := uc.checkSubmissionQuota(ctx, userID, now); err != nil {
+		return 0, err
+	}
+
+	// Bound-check, increment and read the new rank in one atomic call
+	var increment *domain.ScoreIncrement
+	err := uc.writeCache(ctx, userID, func(seed *domain.ScoreSeed) error {
+		var err error
+		increment, err = uc.cacheRepo.IncrementAndRank(ctx, userID, delta, uc.minScore(), uc.maxScore(), seed)
+		return err
+	})
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to update cache: %v", err)
+		uc.releaseSubmissionQuota(ctx, userID, now)
+		return 0, fmt.Errorf("failed to increment score: %w", err)
+	}
+	if !increment.Applied {
+		uc.releaseSubmissionQuota(ctx, userID, now)
+		if increment.Score < uc.minScore() {
+			return increment.Score, fmt.Errorf("%w: %d", domain.ErrScoreBelowMinimum, uc.minScore())
+		}
+		return increment.Score, fmt.Errorf("%w: %d", domain.ErrScoreTooHigh, uc.maxScore())
+	}
+
+	if uc.config.TrackActivity {
+		if err := uc.cacheRepo.TouchActivity(ctx, userID, now); err != nil {
+			uc.logger.Warnf(ctx, "Failed to record user activity: %v", err)
+		}
+	}
+
+	total, err := uc.persistenceRepo.IncrementScore(ctx, userID, delta)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to increment persisted score: %v", err)
+		uc.revertIncrement(ctx, userID, delta)
+		uc.releaseSubmissionQuota(ctx, userID, now)
+		return 0, fmt.Errorf("failed to increment score: %w", err)
+	}
+
+	rank, isNewLeader := increment.Rank, increment.IsNewLeader
+	if total != increment.Score {
+		// Persistence is the source of truth; the cache drifted, e.g. through a concurrent reload
+		uc.logger.Warnf(ctx, "Cached total %d differs from persisted total %d for user %s, correcting cache", increment.Score, total, userID)
+		corrected, err := uc.cacheRepo.SetAndRank(ctx, userID, total)
+		if err != nil {
+			uc.logger.Warnf(ctx, "Failed to correct cached score: %v", err)
+			return total, nil
+		}
+		rank, isNewLeader = corrected.Rank, corrected.IsNewLeader
+	}
+
+	uc.publishEntry(ctx, userID, total, rank, isNewLeader)
+	return total, nil
+}
+
+// ResetScore deletes the user's score so they are no longer ranked, e.g. to start over after practice.
+// Persistence goes first, so a reload of the cache can never bring the score back; a failure part-way leaves
+// the user to retry. Resetting a user without a score succeeds. Returns ErrScoreResetDisabled unless
+// ScoreConfig.AllowScoreReset is set.
+func (uc *scoreUseCase) ResetScore(ctx context.Context, userID string) error {
+	if !uc.config.AllowScoreReset {
+		return domain.ErrScoreResetDisabled
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	if err := uc.persistenceRepo.DeleteScore(ctx, userID); err != nil {
+		uc.logger.Errorf(ctx, "Failed to delete persisted score: %v", err)
+		return fmt.Errorf("failed to reset score: %w", err)
+	}
+
+	if err := uc.cacheRepo.RemoveUser(ctx, userID); err != nil {
+		uc.logger.Errorf(ctx, "Failed to remove user from cache: %v", err)
+		return fmt.Errorf("failed to reset score: %w", err)
+	}
+
+	uc.logger.Infof(ctx, "Score reset: user=%s", userID)
+	return nil
+}
+
+// SetScore overwrites the user's score for admin corrections and testing, using write-through like SubmitScore.
+// MaxScore, MinScore, email verification, the quota and the best-score rule do not apply; only scores beyond
+// ±domain.MaxSafeScore are rejected, since Redis cannot store them exactly. The write is audited with
+// domain.AdminSetReason and broadcast like a submission.
+func (uc *scoreUseCase) SetScore(ctx context.Context, userID string, score int64) error {
+	err := uc.setScore(ctx, userID, score)
+	uc.recordAudit(ctx, userID, score, nil, domain.AdminSetReason, err)
+	return err
+}
+
+func (uc *scoreUseCase) setScore(ctx context.Context, userID string, score int64) error {
+	if score > domain.MaxSafeScore {
+		return fmt.Errorf("%w: %d", domain.ErrScoreTooHigh, domain.MaxSafeScore)
+	}
+	if score < -domain.MaxSafeScore {
+		return fmt.Errorf("%w: %d", domain.ErrScoreBelowMinimum, -domain.MaxSafeScore)
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	var submission *domain.ScoreSubmission
+	err := uc.writeCache(ctx, userID, func(*domain.ScoreSeed) error {
+		var err error
+		submission, err = uc.cacheRepo.SetAndRank(ctx, userID, score)
+		return err
+	})
 	if err != nil {
-		uc.logger.Warnf(ctx, "Failed to get user rank: %v", err)
-		uc.logger.Infof(ctx, "Score updated: user=%s, score=%d", userID, req.Score)
-		// Continue without broadcasting if rank fetch fails
+		uc.logger.Errorf(ctx, "Failed to update cache: %v", err)
+		return fmt.Errorf("failed to set score: %w", err)
+	}
+
+	if err := uc.persistenceRepo.SetScore(ctx, userID, score); err != nil {
+		uc.logger.Errorf(ctx, "Failed to set score: %v", err)
+		return fmt.Errorf("failed to set score: %w", err)
+	}
+
+	uc.logger.Infof(ctx, "Score set by admin: user=%s, score=%d", userID, score)
+	uc.publishEntry(ctx, userID, score, submission.Rank, submission.IsNewLeader)
+	return nil
+}
+
+// revertIncrement undoes a cached increment whose persistence failed, so a retry does not count it twice
+func (uc *scoreUseCase) revertIncrement(ctx context.Context, userID string, delta int64) {
+	ctx, cancel := database.WithQueryTimeout(context.WithoutCancel(ctx), uc.config.QueryTimeout)
+	defer cancel()
+
+	// Unseeded: a user evicted meanwhile is simply left out, and their next write restores the persisted score
+	if _, err := uc.cacheRepo.IncrementAndRank(ctx, userID, -delta, -domain.MaxSafeScore, domain.MaxSafeScore, nil); err != nil {
+		uc.logger.Warnf(ctx, "Failed to revert cached score increment: %v", err)
+	}
+}
+
+// writeCache runs a cache write, first without a seed, and repairs the cache state the write refused:
+// a board that is not loaded (expired or reset) is loaded from persistence, and a user the cache no longer holds
+// (evicted as inactive, or ranked below the loaded top) is seeded with their persisted score, so the write
+// builds on the real board and score instead of starting over. Each repair is tried at most once.
+func (uc *scoreUseCase) writeCache(ctx context.Context, userID string, write func(seed *domain.ScoreSeed) error) error {
+	var seed *domain.ScoreSeed
+	loaded := false
+	for {
+		err := write(seed)
+		switch {
+		case errors.Is(err, domain.ErrLeaderboardNotLoaded) && !loaded:
+			if _, _, err := loadBoard(ctx, uc.cacheRepo, uc.persistenceRepo, uc.logger); err != nil {
+				return err
+			}
+			loaded = true
+		case errors.Is(err, domain.ErrScoreNotCached) && seed == nil:
+			score, found, err := uc.persistenceRepo.GetScore(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("failed to read persisted score: %w", err)
+			}
+			seed = &domain.ScoreSeed{Score: score, Found: found}
+		default:
+			return err
+		}
+	}
+}
+
+// checkEmailVerified rejects users who have not verified their email when verification is required
+func (uc *scoreUseCase) checkEmailVerified(ctx context.Context, userID string) error {
+	if !uc.config.RequireVerifiedEmail {
 		return nil
 	}
+	verified, err := uc.userRepo.IsEmailVerified(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to check email verification: %v", err)
+		return fmt.Errorf("failed to check email verification: %w", err)
+	}
+	if !verified {
+		return domain.ErrEmailNotVerified
+	}
+	return nil
+}
+
+// checkSubmissionQuota counts the submission against the user's daily quota and rejects it once the quota is used up.
+// A submission that then fails to be stored is taken back with releaseSubmissionQuota.
+func (uc *scoreUseCase) checkSubmissionQuota(ctx context.Context, userID string, now time.Time) error {
+	if uc.quotaRepo == nil || uc.config.DailySubmissionQuota <= 0 {
+		return nil
+	}
+	count, err := uc.quotaRepo.Increment(ctx, userID, now)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to count score submission: %v", err)
+		return fmt.Errorf("failed to check submission quota: %w", err)
+	}
+	if count > uc.config.DailySubmissionQuota {
+		resetAt := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+		return &domain.SubmissionQuotaError{Limit: uc.config.DailySubmissionQuota, ResetAt: resetAt}
+	}
+	return nil
+}
+
+// releaseSubmissionQuota takes back a submission counted by checkSubmissionQuota at now, so a failed write does not
+// use up the quota; it runs with a fresh deadline since the failure may have been the request's own timeout
+func (uc *scoreUseCase) releaseSubmissionQuota(ctx context.Context, userID string, now time.Time) {
+	if uc.quotaRepo == nil || uc.config.DailySubmissionQuota <= 0 {
+		return
+	}
+	ctx, cancel := database.WithQueryTimeout(context.WithoutCancel(ctx), uc.config.QueryTimeout)
+	defer cancel()
+
+	if err := uc.quotaRepo.Decrement(ctx, userID, now); err != nil {
+		uc.logger.Warnf(ctx, "Failed to release score submission: %v", err)
+	}
+}
+
+// publishEntry broadcasts the user's new score and rank and notifies a new leader; both are best-effort
+// and are skipped when usernames are required but cannot be loaded
+func (uc *scoreUseCase) publishEntry(ctx context.Context, userID string, score, rank int64, isNewLeader bool) {
+	// Rank 0 means the score is stored but below the board minimum, so there is no entry to show
+	if rank == 0 {
+		uc.logger.Infof(ctx, "Score updated: user=%s, score=%d (below board minimum, skipping)", userID, score)
+		return
+	}
 
 	// Only broadcast if entry is within the broadcast threshold
 	// This optimizes network traffic by skipping updates for very low-ranked entries
 	if rank > domain.MaxBroadcastRank {
-		uc.logger.Infof(ctx, "Score updated: user=%s, score=%d, rank=%d (outside broadcast range, skipping)", userID, req.Score, rank)
-		return nil
+		uc.logger.Infof(ctx, "Score updated: user=%s, score=%d, rank=%d (outside broadcast range, skipping)", userID, score, rank)
+		return
 	}
 
-	// Get username
-	usernames, err := uc.userRepo.GetByIDs(ctx, []string{userID})
-	if err != nil {
-		uc.logger.Warnf(ctx, "Failed to get username: %v", err)
+	notifyLeader := isNewLeader && uc.leaderNotifier != nil
+	if uc.config.DisableBroadcast && !notifyLeader {
+		uc.logger.Infof(ctx, "Score updated: user=%s, score=%d, rank=%d (broadcast disabled, skipping)", userID, score, rank)
+		return
 	}
 
-	username := ""
-	if usernames != nil {
-		if u, ok := usernames[userID]; ok {
-			username = u
-		}
+	// Get username; when usernames are required, skip publishing rather than fail the stored score
+	usernames, err := lookupUsernames(ctx, uc.userRepo, uc.config.Usernames, uc.logger, []string{userID})
+	if err != nil {
+		uc.logger.Warnf(ctx, "Skipping entry update without username: user=%s, score=%d, rank=%d", userID, score, rank)
+		return
 	}
 
 	// Create entry update
 	entry := domain.LeaderboardEntry{
 		UserID:   userID,
-		Username: username,
-		Score:    req.Score,
+		Username: usernames[userID],
+		Score:    score,
 		Rank:     rank,
 	}
 
 	// Broadcast entry update
-	if err := uc.broadcastService.BroadcastEntryUpdate(ctx, &entry); err != nil {
-		uc.logger.Warnf(ctx, "Failed to broadcast entry update: %v", err)
+	if !uc.config.DisableBroadcast {
+		if err := uc.broadcastService.BroadcastEntryUpdate(ctx, &entry); err != nil {
+			uc.logger.Warnf(ctx, "Failed to broadcast entry update: %v", err)
+		}
 	}
 
-	uc.logger.Infof(ctx, "Score updated: user=%s, score=%d, rank=%d", userID, req.Score, rank)
-	return nil
+	if notifyLeader {
+		uc.notifyNewLeader(ctx, &entry)
+	}
+
+	uc.logger.Infof(ctx, "Score updated: user=%s, score=%d, rank=%d", userID, score, rank)
+}
+
+// notifyNewLeader hands the new leader to the notifier; failures are logged and never fail the submission
+func (uc *scoreUseCase) notifyNewLeader(ctx context.Context, entry *domain.LeaderboardEntry) {
+	event := domain.NewLeaderEvent{
+		UserID:   entry.UserID,
+		Username: entry.Username,
+		Score:    entry.Score,
+		BoardID:  domain.GlobalBoardID,
+		At:       uc.now().UTC(),
+	}
+	if err := uc.leaderNotifier.NotifyNewLeader(ctx, &event); err != nil {
+		uc.logger.Warnf(ctx, "Failed to notify new leader: %v", err)
+	}
+}
+
+// reportShadowRejection logs and counts an accepted submission that the score bounds would have rejected.
+// The user ID comes from the request context.
+func (uc *scoreUseCase) reportShadowRejection(ctx context.Context, score int64, shadowErr error) {
+	uc.logger.WithFields(map[string]interface{}{
+		"score":             score,
+		"reason":            shadowErr.Error(),
+		"shadow_rejections": uc.shadowRejections.Add(1),
+	}).Warn(ctx, "Score accepted in shadow mode")
+}
+
+// ShadowRejections returns how many submissions have been accepted in shadow mode since startup
+func (uc *scoreUseCase) ShadowRejections() int64 {
+	return uc.shadowRejections.Load()
+}
+
+// recordAudit writes the outcome of a submission attempt; failures are logged and never fail the submission.
+// delta is set for increments and nil otherwise. acceptedReason is recorded when the attempt succeeded, e.g. the
+// rejection a shadow-mode check would have made.
+func (uc *scoreUseCase) recordAudit(ctx context.Context, userID string, score int64, delta *int64, acceptedReason string, submitErr error) {
+	if uc.auditRepo == nil {
+		return
+	}
+
+	entry := domain.ScoreAuditEntry{
+		UserID:    userID,
+		Score:     score,
+		Delta:     delta,
+		Accepted:  submitErr == nil,
+		CreatedAt: uc.now().UTC(),
+	}
+	if submitErr != nil {
+		entry.Reason = submitErr.Error()
+	} else {
+		entry.Reason = acceptedReason
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.config.QueryTimeout)
+	defer cancel()
+
+	if err := uc.auditRepo.Record(ctx, &entry); err != nil {
+		uc.logger.Warnf(ctx, "Failed to record score audit entry: %v", err)
+	}
 }
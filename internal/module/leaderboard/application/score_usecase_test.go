@@ -3,11 +3,15 @@ package application
 import (
 	"context"
 	"errors"
+	"math"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"real-time-leaderboard/internal/module/leaderboard/domain"
 	"real-time-leaderboard/internal/module/leaderboard/infrastructure/mocks"
 	"real-time-leaderboard/internal/shared/logger"
 )
@@ -20,8 +24,8 @@ func TestScoreUseCase_SubmitScore_WhenValidRequest_ShouldUpdateScoreAndBroadcast
 
 	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
 	mockCacheRepo.EXPECT().
-		UpdateScore(ctx, "user-123", int64(1000)).
-		Return(nil).
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1, Improved: true}, nil).
 		Times(1)
 
 	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
@@ -30,11 +34,6 @@ func TestScoreUseCase_SubmitScore_WhenValidRequest_ShouldUpdateScoreAndBroadcast
 		Return(nil).
 		Times(1)
 
-	mockCacheRepo.EXPECT().
-		GetUserRank(ctx, "user-123").
-		Return(int64(1), nil).
-		Times(1)
-
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockUserRepo.EXPECT().
 		GetByIDs(ctx, []string{"user-123"}).
@@ -48,7 +47,7 @@ func TestScoreUseCase_SubmitScore_WhenValidRequest_ShouldUpdateScoreAndBroadcast
 		Times(1)
 
 	logger := logger.New("info", false)
-	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
 
 	req := SubmitScoreRequest{Score: 1000}
 
@@ -59,7 +58,7 @@ func TestScoreUseCase_SubmitScore_WhenValidRequest_ShouldUpdateScoreAndBroadcast
 	require.NoError(t, err)
 }
 
-func TestScoreUseCase_SubmitScore_WhenPersistenceFails_ShouldReturnInternalError(t *testing.T) {
+func TestScoreUseCase_SubmitScore_WhenZeroScoreAllowed_ShouldStoreZero(t *testing.T) {
 	// ── Arrange ────────────────────────────────────────────────────────
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
@@ -67,10 +66,61 @@ func TestScoreUseCase_SubmitScore_WhenPersistenceFails_ShouldReturnInternalError
 
 	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
 	mockCacheRepo.EXPECT().
-		UpdateScore(ctx, "user-123", int64(1000)).
+		SubmitAndRank(ctx, "user-123", int64(0), nil).
+		Return(&domain.ScoreSubmission{Rank: 3, Improved: true}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(0)).
 		Return(nil).
 		Times(1)
 
+	logger := logger.New("info", false)
+	cfg := ScoreConfig{AllowZeroScore: true, DisableBroadcast: true}
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, nil, cfg, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", SubmitScoreRequest{Score: 0})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenZeroScoreNotAllowed_ShouldRejectWithoutTouchingRepositories(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().SubmitAndRank(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().UpsertScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", SubmitScoreRequest{Score: 0})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrZeroScoreNotAllowed)
+}
+
+func TestScoreUseCase_SubmitScore_WhenPersistenceFails_ShouldReturnInternalError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1, Improved: true}, nil).
+		Times(1)
+
 	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
 	mockPersistenceRepo.EXPECT().
 		UpsertScore(ctx, "user-123", int64(1000)).
@@ -81,7 +131,7 @@ func TestScoreUseCase_SubmitScore_WhenPersistenceFails_ShouldReturnInternalError
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
 
 	req := SubmitScoreRequest{Score: 1000}
 
@@ -102,8 +152,8 @@ func TestScoreUseCase_SubmitScore_WhenCacheUpdateFails_ShouldReturnError(t *test
 
 	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
 	mockCacheRepo.EXPECT().
-		UpdateScore(ctx, "user-123", int64(1000)).
-		Return(errors.New("redis error")).
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(nil, errors.New("redis error")).
 		Times(1)
 
 	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
@@ -111,7 +161,7 @@ func TestScoreUseCase_SubmitScore_WhenCacheUpdateFails_ShouldReturnError(t *test
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
 
 	logger := logger.New("info", false)
-	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
 
 	req := SubmitScoreRequest{Score: 1000}
 
@@ -132,8 +182,8 @@ func TestScoreUseCase_SubmitScore_WhenBroadcastFails_ShouldReturnNilError(t *tes
 
 	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
 	mockCacheRepo.EXPECT().
-		UpdateScore(ctx, "user-123", int64(1000)).
-		Return(nil).
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1, Improved: true}, nil).
 		Times(1)
 
 	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
@@ -142,11 +192,6 @@ func TestScoreUseCase_SubmitScore_WhenBroadcastFails_ShouldReturnNilError(t *tes
 		Return(nil).
 		Times(1)
 
-	mockCacheRepo.EXPECT().
-		GetUserRank(ctx, "user-123").
-		Return(int64(1), nil).
-		Times(1)
-
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockUserRepo.EXPECT().
 		GetByIDs(ctx, []string{"user-123"}).
@@ -160,7 +205,7 @@ func TestScoreUseCase_SubmitScore_WhenBroadcastFails_ShouldReturnNilError(t *tes
 		Times(1)
 
 	logger := logger.New("info", false)
-	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
 
 	req := SubmitScoreRequest{Score: 1000}
 
@@ -179,27 +224,207 @@ func TestScoreUseCase_SubmitScore_WhenRankOutsideBroadcastRange_ShouldNotBroadca
 
 	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
 	mockCacheRepo.EXPECT().
-		UpdateScore(ctx, "user-123", int64(1000)).
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1500, Improved: true}, nil). // Rank outside MaxBroadcastRank (1000)
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(1000)).
+		Return(nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	// Should NOT be called since rank is outside broadcast range
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
+
+	req := SubmitScoreRequest{Score: 1000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	// Broadcast should not be called for ranks outside MaxBroadcastRank
+}
+
+func TestScoreUseCase_SubmitScore_WhenBelowBoardMinimum_ShouldPersistWithoutBroadcast(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(50), nil).
+		Return(&domain.ScoreSubmission{Rank: 0, Improved: true}, nil). // Kept off the board by its minimum
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(50)).
 		Return(nil).
 		Times(1)
 
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	// Should NOT be called since there is no board entry to show
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
+
+	req := SubmitScoreRequest{Score: 50}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenBroadcastDisabled_ShouldStoreScoreWithoutBroadcast(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 3, Improved: true}, nil).
+		Times(1)
+
 	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
 	mockPersistenceRepo.EXPECT().
 		UpsertScore(ctx, "user-123", int64(1000)).
 		Return(nil).
 		Times(1)
 
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().GetByIDs(gomock.Any(), gomock.Any()).Times(0)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().BroadcastEntryUpdate(gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{DisableBroadcast: true}, logger)
+
+	req := SubmitScoreRequest{Score: 1000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenBroadcastDisabledAndUserTakesRankOne_ShouldStillNotifyNewLeader(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(5000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1, Improved: true, IsNewLeader: true}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(5000)).
+		Return(nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(map[string]string{"user-123": "alice"}, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().BroadcastEntryUpdate(gomock.Any(), gomock.Any()).Times(0)
+
+	mockLeaderNotifier := mocks.NewMockLeaderNotifier(ctrl)
+	mockLeaderNotifier.EXPECT().
+		NotifyNewLeader(ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, mockLeaderNotifier, nil, nil, ScoreConfig{DisableBroadcast: true}, logger)
+
+	req := SubmitScoreRequest{Score: 5000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenScoreNotImproved_ShouldSkipPersistenceAndBroadcast(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
 	mockCacheRepo.EXPECT().
-		GetUserRank(ctx, "user-123").
-		Return(int64(1500), nil). // Rank outside MaxBroadcastRank (1000)
+		SubmitAndRank(ctx, "user-123", int64(500), nil).
+		Return(&domain.ScoreSubmission{Rank: 3, Improved: false}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().UpsertScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().BroadcastEntryUpdate(gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
+
+	req := SubmitScoreRequest{Score: 500}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenTrackActivityEnabled_ShouldRecordActivity(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1500, Improved: true}, nil).
+		Times(1)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	mockCacheRepo.EXPECT().
+		TouchActivity(ctx, "user-123", now).
+		Return(nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(1000)).
+		Return(nil).
 		Times(1)
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
-	// Should NOT be called since rank is outside broadcast range
 
 	logger := logger.New("info", false)
-	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, logger)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{TrackActivity: true}, logger)
+	uc.now = func() time.Time { return now }
 
 	req := SubmitScoreRequest{Score: 1000}
 
@@ -208,5 +433,1359 @@ func TestScoreUseCase_SubmitScore_WhenRankOutsideBroadcastRange_ShouldNotBroadca
 
 	// ── Assert ──────────────────────────────────────────────────────────
 	require.NoError(t, err)
-	// Broadcast should not be called for ranks outside MaxBroadcastRank
+}
+
+func TestScoreUseCase_SubmitScore_WhenUserTakesRankOne_ShouldNotifyNewLeader(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(5000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1, Improved: true, IsNewLeader: true}, nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(5000)).
+		Return(nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(map[string]string{"user-123": "alice"}, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		BroadcastEntryUpdate(ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	mockLeaderNotifier := mocks.NewMockLeaderNotifier(ctrl)
+	mockLeaderNotifier.EXPECT().
+		NotifyNewLeader(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, event *domain.NewLeaderEvent) error {
+			require.Equal(t, "user-123", event.UserID)
+			require.Equal(t, "alice", event.Username)
+			require.Equal(t, int64(5000), event.Score)
+			require.Equal(t, domain.GlobalBoardID, event.BoardID)
+			require.Equal(t, now, event.At)
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, mockLeaderNotifier, nil, nil, ScoreConfig{}, logger)
+	uc.now = func() time.Time { return now }
+
+	req := SubmitScoreRequest{Score: 5000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenUserAlreadyLeader_ShouldNotNotify(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(5000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1, Improved: true}, nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(5000)).
+		Return(nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(map[string]string{"user-123": "alice"}, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		BroadcastEntryUpdate(ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	mockLeaderNotifier := mocks.NewMockLeaderNotifier(ctrl)
+	mockLeaderNotifier.EXPECT().NotifyNewLeader(gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, mockLeaderNotifier, nil, nil, ScoreConfig{}, logger)
+
+	req := SubmitScoreRequest{Score: 5000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenUserDoesNotReachRankOne_ShouldNotNotify(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(5000), nil).
+		Return(&domain.ScoreSubmission{Rank: 2, Improved: true}, nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(5000)).
+		Return(nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(map[string]string{"user-123": "alice"}, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		BroadcastEntryUpdate(ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	mockLeaderNotifier := mocks.NewMockLeaderNotifier(ctrl)
+	mockLeaderNotifier.EXPECT().NotifyNewLeader(gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, mockLeaderNotifier, nil, nil, ScoreConfig{}, logger)
+
+	req := SubmitScoreRequest{Score: 5000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenVerificationRequiredAndUserVerified_ShouldUpdateScore(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1500, Improved: true}, nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(1000)).
+		Return(nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		IsEmailVerified(ctx, "user-123").
+		Return(true, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{RequireVerifiedEmail: true}, logger)
+
+	req := SubmitScoreRequest{Score: 1000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenVerificationRequiredAndUserUnverified_ShouldReturnForbiddenError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().SubmitAndRank(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().UpsertScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		IsEmailVerified(ctx, "user-123").
+		Return(false, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{RequireVerifiedEmail: true}, logger)
+
+	req := SubmitScoreRequest{Score: 1000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrEmailNotVerified)
+}
+
+func TestScoreUseCase_SubmitScore_WhenSubmissionRejected_ShouldStillRecordAudit(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().SubmitAndRank(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		IsEmailVerified(ctx, "user-123").
+		Return(false, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.ScoreAuditEntry) error {
+			require.Equal(t, "user-123", entry.UserID)
+			require.Equal(t, int64(1000), entry.Score)
+			require.False(t, entry.Accepted)
+			require.Equal(t, domain.ErrEmailNotVerified.Error(), entry.Reason)
+			require.False(t, entry.CreatedAt.IsZero())
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, nil, ScoreConfig{RequireVerifiedEmail: true}, logger)
+
+	req := SubmitScoreRequest{Score: 1000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrEmailNotVerified)
+}
+
+func TestScoreUseCase_SubmitScore_WhenCacheUpdateFails_ShouldRecordRejectedAudit(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(nil, errors.New("redis error")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.ScoreAuditEntry) error {
+			require.False(t, entry.Accepted)
+			require.Contains(t, entry.Reason, "failed to update score")
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, nil, ScoreConfig{}, logger)
+
+	req := SubmitScoreRequest{Score: 1000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenAccepted_ShouldRecordAuditAndIgnoreAuditFailure(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1500, Improved: true}, nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(1000)).
+		Return(nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.ScoreAuditEntry) error {
+			require.True(t, entry.Accepted)
+			require.Empty(t, entry.Reason)
+			require.Equal(t, now, entry.CreatedAt)
+			return errors.New("db error")
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, nil, ScoreConfig{}, logger)
+	uc.now = func() time.Time { return now }
+
+	req := SubmitScoreRequest{Score: 1000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenPersistenceExceedsQueryTimeout_ShouldFailAndStillRecordAudit(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(gomock.Any(), "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1, Improved: true}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(gomock.Any(), "user-123", int64(1000)).
+		DoAndReturn(func(ctx context.Context, _ string, _ int64) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entry *domain.ScoreAuditEntry) error {
+			// The audit write gets its own deadline, not the expired one
+			require.NoError(t, ctx.Err())
+			require.False(t, entry.Accepted)
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, nil, ScoreConfig{QueryTimeout: 10 * time.Millisecond}, logger)
+
+	req := SubmitScoreRequest{Score: 1000}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestScoreUseCase_SubmitScore_WhenScoreAboveFloatSafeLimit_ShouldReturnValidationError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().SubmitAndRank(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().UpsertScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	// A configured max above 2^53 is capped to the float64-safe limit
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{MaxScore: math.MaxInt64}, logger)
+
+	req := SubmitScoreRequest{Score: 1<<53 + 1}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrScoreTooHigh)
+}
+
+func TestScoreUseCase_SubmitScore_WhenScoreAboveConfiguredMax_ShouldReturnValidationError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().SubmitAndRank(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().UpsertScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{MaxScore: 10000}, logger)
+
+	req := SubmitScoreRequest{Score: 10001}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrScoreTooHigh)
+	require.Contains(t, err.Error(), "10000")
+}
+
+func TestScoreUseCase_SubmitScore_WhenAboveMaxInShadowMode_ShouldAcceptAndAuditShadowReason(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(10001), nil).
+		Return(&domain.ScoreSubmission{Rank: 1500, Improved: true}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(10001)).
+		Return(nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.ScoreAuditEntry) error {
+			require.True(t, entry.Accepted)
+			require.True(t, strings.HasPrefix(entry.Reason, domain.ShadowReasonPrefix))
+			require.Contains(t, entry.Reason, domain.ErrScoreTooHigh.Error())
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, nil, ScoreConfig{MaxScore: 10000, ShadowMaxScore: true}, logger)
+
+	req := SubmitScoreRequest{Score: 10001}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(1), uc.ShadowRejections())
+}
+
+func TestScoreUseCase_SubmitScore_WhenAboveSafeLimitInShadowMode_ShouldStillReject(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().SubmitAndRank(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.ScoreAuditEntry) error {
+			require.False(t, entry.Accepted)
+			require.False(t, strings.HasPrefix(entry.Reason, domain.ShadowReasonPrefix))
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, nil, ScoreConfig{MaxScore: 10000, ShadowMaxScore: true}, logger)
+
+	req := SubmitScoreRequest{Score: domain.MaxSafeScore + 1}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrScoreTooHigh)
+	require.Zero(t, uc.ShadowRejections())
+}
+
+func TestScoreUseCase_SetScore_WhenAboveConfiguredMax_ShouldSetExactScoreAndAudit(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().SubmitAndRank(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockCacheRepo.EXPECT().
+		SetAndRank(ctx, "user-123", int64(20000)).
+		Return(&domain.ScoreSubmission{Rank: 1500, Improved: true}, nil).
+		Times(1)
+
+	// The admin path overwrites persistence too, rather than keeping the better score
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().UpsertScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockPersistenceRepo.EXPECT().
+		SetScore(ctx, "user-123", int64(20000)).
+		Return(nil).
+		Times(1)
+
+	// Email verification and the quota do not apply to an admin set
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().IsEmailVerified(gomock.Any(), gomock.Any()).Times(0)
+	mockQuotaRepo := mocks.NewMockSubmissionQuotaRepository(ctrl)
+	mockQuotaRepo.EXPECT().Increment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.ScoreAuditEntry) error {
+			require.Equal(t, "user-123", entry.UserID)
+			require.Equal(t, int64(20000), entry.Score)
+			require.True(t, entry.Accepted)
+			require.Equal(t, domain.AdminSetReason, entry.Reason)
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	cfg := ScoreConfig{MaxScore: 10000, RequireVerifiedEmail: true, DailySubmissionQuota: 1}
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, mockQuotaRepo, cfg, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SetScore(ctx, "user-123", 20000)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SetScore_WhenAboveSafeLimit_ShouldRejectAndAudit(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().SetAndRank(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.ScoreAuditEntry) error {
+			require.False(t, entry.Accepted)
+			require.Contains(t, entry.Reason, domain.ErrScoreTooHigh.Error())
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SetScore(ctx, "user-123", domain.MaxSafeScore+1)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrScoreTooHigh)
+}
+
+func TestScoreUseCase_ValidateScore_WhenWithinBounds_ShouldAcceptWithoutTouchingRepositories(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, nil, ScoreConfig{MaxScore: 10000}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.ValidateScore(ctx, SubmitScoreRequest{Score: 10000})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_ValidateScore_WhenAboveConfiguredMax_ShouldRejectLikeSubmitScore(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, mockAuditRepo, nil, ScoreConfig{MaxScore: 10000}, logger)
+	req := SubmitScoreRequest{Score: 10001}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	validateErr := uc.ValidateScore(ctx, req)
+	submitErr := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, validateErr, domain.ErrScoreTooHigh)
+	require.EqualError(t, validateErr, submitErr.Error())
+}
+
+func TestScoreUseCase_IncrementScore_WhenApplied_ShouldPersistDeltaAndBroadcastNewTotal(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		IncrementAndRank(ctx, "user-123", int64(50), int64(0), domain.MaxSafeScore, nil).
+		Return(&domain.ScoreIncrement{Score: 1050, Rank: 3, Applied: true}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		IncrementScore(ctx, "user-123", int64(50)).
+		Return(int64(1050), nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(map[string]string{"user-123": "alice"}, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		BroadcastEntryUpdate(ctx, &domain.LeaderboardEntry{UserID: "user-123", Username: "alice", Score: 1050, Rank: 3}).
+		Return(nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.IncrementScore(ctx, "user-123", 50)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(1050), total)
+}
+
+func TestScoreUseCase_IncrementScore_WhenApplied_ShouldRecordAuditWithDelta(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		IncrementAndRank(ctx, "user-123", int64(50), int64(0), domain.MaxSafeScore, nil).
+		Return(&domain.ScoreIncrement{Score: 1050, Rank: 1500, Applied: true}, nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		IncrementScore(ctx, "user-123", int64(50)).
+		Return(int64(1050), nil).
+		Times(1)
+
+	delta := int64(50)
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.ScoreAuditEntry) error {
+			require.Equal(t, "user-123", entry.UserID)
+			require.Equal(t, int64(1050), entry.Score)
+			require.Equal(t, &delta, entry.Delta)
+			require.True(t, entry.Accepted)
+			require.Empty(t, entry.Reason)
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, mockAuditRepo, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.IncrementScore(ctx, "user-123", delta)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(1050), total)
+}
+
+func TestScoreUseCase_IncrementScore_WhenTotalOutOfBounds_ShouldRecordRejectedAuditWithDelta(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		IncrementAndRank(ctx, "user-123", int64(-200), int64(0), domain.MaxSafeScore, nil).
+		Return(&domain.ScoreIncrement{Score: -150, Applied: false}, nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().IncrementScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	delta := int64(-200)
+	mockAuditRepo := mocks.NewMockScoreAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().
+		Record(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.ScoreAuditEntry) error {
+			require.Equal(t, int64(-150), entry.Score)
+			require.Equal(t, &delta, entry.Delta)
+			require.False(t, entry.Accepted)
+			require.Contains(t, entry.Reason, domain.ErrScoreBelowMinimum.Error())
+			return nil
+		}).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, mockAuditRepo, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.IncrementScore(ctx, "user-123", delta)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrScoreBelowMinimum)
+	require.Zero(t, total)
+}
+
+func TestScoreUseCase_IncrementScore_WhenTotalBelowConfiguredMinimum_ShouldReturnValidationError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		IncrementAndRank(ctx, "user-123", int64(-200), int64(-100), domain.MaxSafeScore, nil).
+		Return(&domain.ScoreIncrement{Score: -150, Applied: false}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().IncrementScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{MinScore: -100}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := uc.IncrementScore(ctx, "user-123", -200)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrScoreBelowMinimum)
+	require.Contains(t, err.Error(), "-100")
+}
+
+func TestScoreUseCase_IncrementScore_WhenPersistenceFails_ShouldRevertCachedIncrement(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	gomock.InOrder(
+		mockCacheRepo.EXPECT().
+			IncrementAndRank(ctx, "user-123", int64(50), int64(0), domain.MaxSafeScore, nil).
+			Return(&domain.ScoreIncrement{Score: 150, Rank: 1, Applied: true}, nil),
+		mockCacheRepo.EXPECT().
+			IncrementAndRank(gomock.Any(), "user-123", int64(-50), -domain.MaxSafeScore, domain.MaxSafeScore, nil).
+			Return(&domain.ScoreIncrement{Score: 100, Rank: 1, Applied: true}, nil),
+	)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		IncrementScore(ctx, "user-123", int64(50)).
+		Return(int64(0), errors.New("database error")).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := uc.IncrementScore(ctx, "user-123", 50)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to increment score")
+	require.Contains(t, err.Error(), "database error")
+}
+
+func TestScoreUseCase_IncrementScore_WhenUserEvictedFromCache_ShouldSeedPersistedScore(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	gomock.InOrder(
+		mockCacheRepo.EXPECT().
+			IncrementAndRank(ctx, "user-123", int64(50), int64(0), domain.MaxSafeScore, nil).
+			Return(nil, domain.ErrScoreNotCached),
+		mockCacheRepo.EXPECT().
+			IncrementAndRank(ctx, "user-123", int64(50), int64(0), domain.MaxSafeScore, &domain.ScoreSeed{Score: 1000, Found: true}).
+			Return(&domain.ScoreIncrement{Score: 1050, Rank: 3, Applied: true}, nil),
+	)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetScore(ctx, "user-123").
+		Return(int64(1000), true, nil).
+		Times(1)
+	mockPersistenceRepo.EXPECT().
+		IncrementScore(ctx, "user-123", int64(50)).
+		Return(int64(1050), nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(map[string]string{"user-123": "alice"}, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		BroadcastEntryUpdate(ctx, &domain.LeaderboardEntry{UserID: "user-123", Username: "alice", Score: 1050, Rank: 3}).
+		Return(nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.IncrementScore(ctx, "user-123", 50)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(1050), total)
+}
+
+func TestScoreUseCase_SubmitScore_WhenUserEvictedFromCacheWithBetterBest_ShouldNotImprove(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	gomock.InOrder(
+		mockCacheRepo.EXPECT().
+			SubmitAndRank(ctx, "user-123", int64(500), nil).
+			Return(nil, domain.ErrScoreNotCached),
+		mockCacheRepo.EXPECT().
+			SubmitAndRank(ctx, "user-123", int64(500), &domain.ScoreSeed{Score: 1000, Found: true}).
+			Return(&domain.ScoreSubmission{Rank: 2, Improved: false}, nil),
+	)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetScore(ctx, "user-123").
+		Return(int64(1000), true, nil).
+		Times(1)
+	mockPersistenceRepo.EXPECT().UpsertScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().BroadcastEntryUpdate(gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", SubmitScoreRequest{Score: 500})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_IncrementScore_WhenPersistedTotalDiffers_ShouldCorrectCacheAndReturnPersistedTotal(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		IncrementAndRank(ctx, "user-123", int64(50), int64(0), domain.MaxSafeScore, nil).
+		Return(&domain.ScoreIncrement{Score: 50, Rank: 9, Applied: true}, nil).
+		Times(1)
+	mockCacheRepo.EXPECT().
+		SetAndRank(ctx, "user-123", int64(1050)).
+		Return(&domain.ScoreSubmission{Rank: 3, Improved: true}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		IncrementScore(ctx, "user-123", int64(50)).
+		Return(int64(1050), nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(map[string]string{"user-123": "alice"}, nil).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		BroadcastEntryUpdate(ctx, &domain.LeaderboardEntry{UserID: "user-123", Username: "alice", Score: 1050, Rank: 3}).
+		Return(nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.IncrementScore(ctx, "user-123", 50)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(1050), total)
+}
+
+func TestScoreUseCase_SubmitScore_WhenBoardExpired_ShouldLoadBoardBeforeWriting(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	gomock.InOrder(
+		mockCacheRepo.EXPECT().
+			SubmitAndRank(ctx, "user-123", int64(1000), nil).
+			Return(nil, domain.ErrLeaderboardNotLoaded),
+		mockPersistenceRepo.EXPECT().
+			GetLeaderboard(ctx, int64(domain.MaxBroadcastRank), int64(0)).
+			Return([]domain.LeaderboardEntry{{UserID: "user-9", Score: 5000, Rank: 1}}, int64(1), nil),
+		mockCacheRepo.EXPECT().
+			UpdateScore(ctx, "user-9", int64(5000)).
+			Return(nil),
+		mockCacheRepo.EXPECT().
+			MarkLoaded(ctx).
+			Return(nil),
+		mockCacheRepo.EXPECT().
+			SubmitAndRank(ctx, "user-123", int64(1000), nil).
+			Return(&domain.ScoreSubmission{Rank: 2, Improved: true}, nil),
+	)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(1000)).
+		Return(nil).
+		Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(map[string]string{"user-123": "alice"}, nil).
+		Times(1)
+
+	// Ranked against the loaded board, the user is second rather than the leader of an empty board
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		BroadcastEntryUpdate(ctx, &domain.LeaderboardEntry{UserID: "user-123", Username: "alice", Score: 1000, Rank: 2}).
+		Return(nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", SubmitScoreRequest{Score: 1000})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_IncrementScore_WhenBoardCannotBeMarkedLoaded_ShouldFailWithoutPersisting(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		IncrementAndRank(ctx, "user-123", int64(50), int64(0), domain.MaxSafeScore, nil).
+		Return(nil, domain.ErrLeaderboardNotLoaded).
+		Times(2)
+	mockCacheRepo.EXPECT().
+		MarkLoaded(ctx).
+		Return(errors.New("redis down")).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		GetLeaderboard(ctx, int64(domain.MaxBroadcastRank), int64(0)).
+		Return([]domain.LeaderboardEntry{}, int64(0), nil).
+		Times(1)
+	mockPersistenceRepo.EXPECT().IncrementScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := uc.IncrementScore(ctx, "user-123", 50)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrLeaderboardNotLoaded)
+}
+
+func TestScoreUseCase_SubmitScore_WhenUsernameLookupFails_ShouldBroadcastFallbackUsername(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 2, Improved: true}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().UpsertScore(ctx, "user-123", int64(1000)).Return(nil).Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(nil, errors.New("database error")).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().
+		BroadcastEntryUpdate(ctx, &domain.LeaderboardEntry{UserID: "user-123", Username: "Unknown", Score: 1000, Rank: 2}).
+		Return(nil).
+		Times(1)
+
+	logger := logger.New("info", false)
+	cfg := ScoreConfig{Usernames: UsernameConfig{Fallback: "Unknown"}}
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, nil, nil, nil, cfg, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", SubmitScoreRequest{Score: 1000})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenUsernameLookupFailsAndUsernamesRequired_ShouldSkipBroadcastButStoreScore(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(1000), nil).
+		Return(&domain.ScoreSubmission{Rank: 1, Improved: true, IsNewLeader: true}, nil).
+		Times(1)
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().UpsertScore(ctx, "user-123", int64(1000)).Return(nil).Times(1)
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-123"}).
+		Return(nil, errors.New("database error")).
+		Times(1)
+
+	mockBroadcastService := mocks.NewMockBroadcastService(ctrl)
+	mockBroadcastService.EXPECT().BroadcastEntryUpdate(gomock.Any(), gomock.Any()).Times(0)
+	mockNotifier := mocks.NewMockLeaderNotifier(ctrl)
+	mockNotifier.EXPECT().NotifyNewLeader(gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	cfg := ScoreConfig{Usernames: UsernameConfig{Required: true}}
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mockUserRepo, mockBroadcastService, mockNotifier, nil, nil, cfg, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", SubmitScoreRequest{Score: 1000})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_SubmitScore_WhenDailyQuotaReached_ShouldRejectUntilNextDay(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Count submissions per UTC day like the Redis counters do
+	counts := map[string]int64{}
+	mockQuotaRepo := mocks.NewMockSubmissionQuotaRepository(ctrl)
+	mockQuotaRepo.EXPECT().
+		Increment(ctx, "user-123", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, at time.Time) (int64, error) {
+			day := at.UTC().Format(time.DateOnly)
+			counts[day]++
+			return counts[day], nil
+		}).
+		Times(4)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(100), nil).
+		Return(&domain.ScoreSubmission{Rank: 5, Improved: false}, nil).
+		Times(3)
+
+	logger := logger.New("info", false)
+	cfg := ScoreConfig{DailySubmissionQuota: 2}
+	uc := NewScoreUseCase(mocks.NewMockLeaderboardPersistenceRepository(ctrl), mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, mockQuotaRepo, cfg, logger)
+	now := time.Date(2026, 10, 16, 23, 30, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	req := SubmitScoreRequest{Score: 100}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	firstErr := uc.SubmitScore(ctx, "user-123", req)
+	secondErr := uc.SubmitScore(ctx, "user-123", req)
+	overQuotaErr := uc.SubmitScore(ctx, "user-123", req)
+	now = now.Add(time.Hour)
+	nextDayErr := uc.SubmitScore(ctx, "user-123", req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.ErrorIs(t, overQuotaErr, domain.ErrSubmissionQuotaExceeded)
+	var quotaErr *domain.SubmissionQuotaError
+	require.ErrorAs(t, overQuotaErr, &quotaErr)
+	require.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), quotaErr.ResetAt)
+	require.NoError(t, nextDayErr)
+}
+
+func TestScoreUseCase_IncrementScore_WhenDailyQuotaReached_ShouldReject(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	mockQuotaRepo := mocks.NewMockSubmissionQuotaRepository(ctrl)
+	mockQuotaRepo.EXPECT().
+		Increment(ctx, "user-123", now).
+		Return(int64(3), nil).
+		Times(1)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().IncrementAndRank(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().IncrementScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	cfg := ScoreConfig{DailySubmissionQuota: 2}
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, mockQuotaRepo, cfg, logger)
+	uc.now = func() time.Time { return now }
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	total, err := uc.IncrementScore(ctx, "user-123", 50)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrSubmissionQuotaExceeded)
+	require.Zero(t, total)
+}
+
+func TestScoreUseCase_IncrementScore_WhenTotalOutOfBounds_ShouldReleaseQuota(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	mockQuotaRepo := mocks.NewMockSubmissionQuotaRepository(ctrl)
+	gomock.InOrder(
+		mockQuotaRepo.EXPECT().Increment(ctx, "user-123", now).Return(int64(1), nil),
+		mockQuotaRepo.EXPECT().Decrement(gomock.Any(), "user-123", now).Return(nil),
+	)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		IncrementAndRank(ctx, "user-123", int64(-50), int64(0), domain.MaxSafeScore, nil).
+		Return(&domain.ScoreIncrement{Applied: false, Score: -20}, nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().IncrementScore(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	cfg := ScoreConfig{DailySubmissionQuota: 2}
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, mockQuotaRepo, cfg, logger)
+	uc.now = func() time.Time { return now }
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := uc.IncrementScore(ctx, "user-123", -50)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrScoreBelowMinimum)
+}
+
+func TestScoreUseCase_SubmitScore_WhenPersistenceFails_ShouldReleaseQuota(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	mockQuotaRepo := mocks.NewMockSubmissionQuotaRepository(ctrl)
+	gomock.InOrder(
+		mockQuotaRepo.EXPECT().Increment(ctx, "user-123", now).Return(int64(1), nil),
+		mockQuotaRepo.EXPECT().Decrement(gomock.Any(), "user-123", now).Return(nil),
+	)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().
+		SubmitAndRank(ctx, "user-123", int64(100), nil).
+		Return(&domain.ScoreSubmission{Rank: 5, Improved: true}, nil).
+		Times(1)
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		UpsertScore(ctx, "user-123", int64(100)).
+		Return(errors.New("database unavailable")).
+		Times(1)
+
+	logger := logger.New("info", false)
+	cfg := ScoreConfig{DailySubmissionQuota: 2}
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, mockQuotaRepo, cfg, logger)
+	uc.now = func() time.Time { return now }
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.SubmitScore(ctx, "user-123", SubmitScoreRequest{Score: 100})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.NotErrorIs(t, err, domain.ErrSubmissionQuotaExceeded)
+}
+
+func TestScoreUseCase_ResetScore_WhenAllowed_ShouldDeletePersistedScoreThenRemoveFromCache(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	gomock.InOrder(
+		mockPersistenceRepo.EXPECT().DeleteScore(gomock.Any(), "user-123").Return(nil),
+		mockCacheRepo.EXPECT().RemoveUser(gomock.Any(), "user-123").Return(nil),
+	)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, nil, ScoreConfig{AllowScoreReset: true}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.ResetScore(ctx, "user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+}
+
+func TestScoreUseCase_ResetScore_WhenPersistenceFails_ShouldKeepCachedScore(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().
+		DeleteScore(gomock.Any(), "user-123").
+		Return(errors.New("database error")).
+		Times(1)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().RemoveUser(gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, nil, ScoreConfig{AllowScoreReset: true}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.ResetScore(ctx, "user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to reset score")
+}
+
+func TestScoreUseCase_ResetScore_WhenNotAllowed_ShouldReturnErrScoreResetDisabled(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPersistenceRepo := mocks.NewMockLeaderboardPersistenceRepository(ctrl)
+	mockPersistenceRepo.EXPECT().DeleteScore(gomock.Any(), gomock.Any()).Times(0)
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().RemoveUser(gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logger.New("info", false)
+	uc := NewScoreUseCase(mockPersistenceRepo, mockCacheRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockBroadcastService(ctrl), nil, nil, nil, ScoreConfig{}, logger)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := uc.ResetScore(ctx, "user-123")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrScoreResetDisabled)
 }
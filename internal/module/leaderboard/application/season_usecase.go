@@ -0,0 +1,266 @@
+// Package application provides use cases for the leaderboard module.
+package application
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/database"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+//go:generate mockgen -destination=../adapters/mocks/season_usecase_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application SeasonUseCase
+
+// SeasonUseCase defines the interface for starting, ending and listing seasons and querying archived standings
+type SeasonUseCase interface {
+	StartSeason(ctx context.Context, name string) (*domain.Season, error)
+	EndSeason(ctx context.Context) (*domain.Season, error)
+	ListSeasons(ctx context.Context, limit, offset int64) ([]domain.Season, int64, error)
+	GetBestRankEver(ctx context.Context, userID string) (*domain.BestRank, error)
+	GetRankHistory(ctx context.Context, userID string, start, end time.Time) ([]domain.RankPoint, error)
+	GetTopMovers(ctx context.Context, since time.Time, limit int) (*domain.TopMovers, error)
+}
+
+// DefaultTopMoversLimit is the number of climbers and fallers returned when the request does not set a limit
+const DefaultTopMoversLimit = 10
+
+// GetTopMoversRequest represents a top movers query; a zero Since compares with the season before the latest
+type GetTopMoversRequest struct {
+	Since time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+	Limit int       `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// seasonUseCase implements SeasonUseCase interface
+type seasonUseCase struct {
+	seasonRepo   SeasonRepository
+	cacheRepo    LeaderboardCacheRepository
+	queryTimeout time.Duration
+	now          func() time.Time
+	logger       *logger.Logger
+}
+
+// NewSeasonUseCase creates a new season use case.
+// queryTimeout bounds the repository calls of each operation (0 disables).
+//
+//nolint:revive // unexported-return: intentional design - accept interface, return struct
+func NewSeasonUseCase(seasonRepo SeasonRepository, cacheRepo LeaderboardCacheRepository, queryTimeout time.Duration, l *logger.Logger) *seasonUseCase {
+	return &seasonUseCase{
+		seasonRepo:   seasonRepo,
+		cacheRepo:    cacheRepo,
+		queryTimeout: queryTimeout,
+		now:          time.Now,
+		logger:       l,
+	}
+}
+
+// StartSeason archives and resets the board for the active season, if any, then opens a new season.
+// When no season is active the scores already on the board carry into the new season.
+func (uc *seasonUseCase) StartSeason(ctx context.Context, name string) (*domain.Season, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	active, err := uc.seasonRepo.GetActive(ctx)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get active season: %v", err)
+		return nil, fmt.Errorf("failed to get active season: %w", err)
+	}
+
+	now := uc.now()
+	if active != nil {
+		if err := uc.archive(ctx, active, now); err != nil {
+			return nil, err
+		}
+	}
+
+	season := &domain.Season{Name: name, StartedAt: now}
+	if err := uc.seasonRepo.Create(ctx, season); err != nil {
+		uc.logger.Errorf(ctx, "Failed to create season: %v", err)
+		return nil, fmt.Errorf("failed to create season: %w", err)
+	}
+
+	uc.logger.Infof(ctx, "Season started: %s (%s)", season.ID, season.Name)
+	return season, nil
+}
+
+// EndSeason archives the active season's standings and resets the board without opening a new season
+func (uc *seasonUseCase) EndSeason(ctx context.Context) (*domain.Season, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	active, err := uc.seasonRepo.GetActive(ctx)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get active season: %v", err)
+		return nil, fmt.Errorf("failed to get active season: %w", err)
+	}
+	if active == nil {
+		return nil, domain.ErrNoActiveSeason
+	}
+
+	if err := uc.archive(ctx, active, uc.now()); err != nil {
+		return nil, err
+	}
+
+	return active, nil
+}
+
+// archive snapshots the persisted board into season's standings, ends it at endedAt and clears the cached board
+func (uc *seasonUseCase) archive(ctx context.Context, season *domain.Season, endedAt time.Time) error {
+	if err := uc.seasonRepo.Archive(ctx, season.ID, endedAt); err != nil {
+		uc.logger.Errorf(ctx, "Failed to archive season %s: %v", season.ID, err)
+		return fmt.Errorf("failed to archive season: %w", err)
+	}
+	season.EndedAt = &endedAt
+
+	// The persisted board is already empty; a stale cache would keep serving last season's scores
+	if err := uc.cacheRepo.Reset(ctx); err != nil {
+		uc.logger.Errorf(ctx, "Failed to reset leaderboard cache after archiving season %s: %v", season.ID, err)
+		return fmt.Errorf("failed to reset leaderboard cache: %w", err)
+	}
+
+	uc.logger.Infof(ctx, "Season ended: %s (%s)", season.ID, season.Name)
+	return nil
+}
+
+// ListSeasons retrieves seasons newest first
+func (uc *seasonUseCase) ListSeasons(ctx context.Context, limit, offset int64) ([]domain.Season, int64, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	seasons, total, err := uc.seasonRepo.List(ctx, limit, offset)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to list seasons: %v", err)
+		return nil, 0, fmt.Errorf("failed to retrieve seasons: %w", err)
+	}
+
+	return seasons, total, nil
+}
+
+// GetBestRankEver retrieves the best final rank the user held in any ended season and when that season ended
+func (uc *seasonUseCase) GetBestRankEver(ctx context.Context, userID string) (*domain.BestRank, error) {
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	best, err := uc.seasonRepo.GetBestRank(ctx, userID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get best rank for user %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to retrieve best rank: %w", err)
+	}
+	if best == nil {
+		return nil, domain.ErrNoSeasonStandings
+	}
+
+	return best, nil
+}
+
+// GetRankHistory retrieves the user's final rank in each season that ended between start and end, oldest first.
+// A zero end means now. Seasons the user did not place in are returned unranked rather than skipped.
+func (uc *seasonUseCase) GetRankHistory(ctx context.Context, userID string, start, end time.Time) ([]domain.RankPoint, error) {
+	if end.IsZero() {
+		end = uc.now()
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	points, err := uc.seasonRepo.GetRankHistory(ctx, userID, start, end)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get rank history for user %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to retrieve rank history: %w", err)
+	}
+
+	return points, nil
+}
+
+// GetTopMovers compares the final standings of the latest ended season with those of the season that ended
+// closest to since (the one before it when since is zero) and returns up to limit climbers and fallers.
+// It returns domain.ErrNotEnoughSeasons when there is no earlier ended season to compare with.
+func (uc *seasonUseCase) GetTopMovers(ctx context.Context, since time.Time, limit int) (*domain.TopMovers, error) {
+	if limit <= 0 {
+		limit = DefaultTopMoversLimit
+	}
+
+	ctx, cancel := database.WithQueryTimeout(ctx, uc.queryTimeout)
+	defer cancel()
+
+	latest, err := uc.seasonRepo.GetLatestEnded(ctx)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get latest ended season: %v", err)
+		return nil, fmt.Errorf("failed to retrieve top movers: %w", err)
+	}
+	if latest == nil {
+		return nil, domain.ErrNotEnoughSeasons
+	}
+	if since.IsZero() {
+		since = *latest.EndedAt
+	}
+
+	baseline, err := uc.seasonRepo.GetEndedNearest(ctx, since, *latest.EndedAt)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get season ended nearest %s: %v", since, err)
+		return nil, fmt.Errorf("failed to retrieve top movers: %w", err)
+	}
+	if baseline == nil {
+		return nil, domain.ErrNotEnoughSeasons
+	}
+
+	previous, err := uc.seasonRepo.GetStandings(ctx, baseline.ID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get standings of season %s: %v", baseline.ID, err)
+		return nil, fmt.Errorf("failed to retrieve top movers: %w", err)
+	}
+	current, err := uc.seasonRepo.GetStandings(ctx, latest.ID)
+	if err != nil {
+		uc.logger.Errorf(ctx, "Failed to get standings of season %s: %v", latest.ID, err)
+		return nil, fmt.Errorf("failed to retrieve top movers: %w", err)
+	}
+
+	climbers, fallers := rankMovers(previous, current, limit)
+	return &domain.TopMovers{From: *baseline, To: *latest, Climbers: climbers, Fallers: fallers}, nil
+}
+
+// rankMovers pairs each player's previous and current rank and returns the limit biggest climbers and fallers,
+// ties broken by user ID. A player missing from one side counts as one place below that side's last player.
+func rankMovers(previous, current []domain.SeasonStanding, limit int) (climbers, fallers []domain.Mover) {
+	movers := make(map[string]*domain.Mover, len(previous)+len(current))
+	for _, s := range previous {
+		movers[s.UserID] = &domain.Mover{UserID: s.UserID, PreviousRank: s.Rank}
+	}
+	for _, s := range current {
+		m, ok := movers[s.UserID]
+		if !ok {
+			m = &domain.Mover{UserID: s.UserID}
+			movers[s.UserID] = m
+		}
+		m.CurrentRank = s.Rank
+	}
+
+	climbers, fallers = []domain.Mover{}, []domain.Mover{}
+	for _, m := range movers {
+		from, to := m.PreviousRank, m.CurrentRank
+		if from == 0 {
+			from = int64(len(previous)) + 1
+		}
+		if to == 0 {
+			to = int64(len(current)) + 1
+		}
+		m.Change = from - to
+		switch {
+		case m.Change > 0:
+			climbers = append(climbers, *m)
+		case m.Change < 0:
+			fallers = append(fallers, *m)
+		}
+	}
+
+	slices.SortFunc(climbers, func(a, b domain.Mover) int {
+		return cmp.Or(cmp.Compare(b.Change, a.Change), cmp.Compare(a.UserID, b.UserID))
+	})
+	slices.SortFunc(fallers, func(a, b domain.Mover) int {
+		return cmp.Or(cmp.Compare(a.Change, b.Change), cmp.Compare(a.UserID, b.UserID))
+	})
+	return climbers[:min(limit, len(climbers))], fallers[:min(limit, len(fallers))]
+}
@@ -0,0 +1,261 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/module/leaderboard/infrastructure/mocks"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+func TestSeasonUseCase_StartSeason_WhenSeasonActive_ShouldSnapshotThenClearBoardAndRecordSeason(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	active := &domain.Season{ID: "season-1", Name: "Season 1", StartedAt: now.AddDate(0, -1, 0)}
+
+	mockSeasonRepo := mocks.NewMockSeasonRepository(ctrl)
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+
+	var created *domain.Season
+	gomock.InOrder(
+		mockSeasonRepo.EXPECT().GetActive(ctx).Return(active, nil).Times(1),
+		mockSeasonRepo.EXPECT().Archive(ctx, "season-1", now).Return(nil).Times(1),
+		mockCacheRepo.EXPECT().Reset(ctx).Return(nil).Times(1),
+		mockSeasonRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, season *domain.Season) error {
+				season.ID = "season-2"
+				created = season
+				return nil
+			}).
+			Times(1),
+	)
+
+	uc := NewSeasonUseCase(mockSeasonRepo, mockCacheRepo, 0, logger.New("info", false))
+	uc.now = func() time.Time { return now }
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	season, err := uc.StartSeason(ctx, "Season 2")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Same(t, created, season)
+	require.Equal(t, "season-2", season.ID)
+	require.Equal(t, "Season 2", season.Name)
+	require.Equal(t, now, season.StartedAt)
+	require.True(t, season.IsActive())
+	require.NotNil(t, active.EndedAt)
+	require.Equal(t, now, *active.EndedAt)
+}
+
+func TestSeasonUseCase_StartSeason_WhenNoSeasonActive_ShouldKeepBoardAndRecordSeason(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeasonRepo := mocks.NewMockSeasonRepository(ctrl)
+	mockSeasonRepo.EXPECT().GetActive(ctx).Return(nil, nil).Times(1)
+	mockSeasonRepo.EXPECT().Archive(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockSeasonRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().Reset(gomock.Any()).Times(0)
+
+	uc := NewSeasonUseCase(mockSeasonRepo, mockCacheRepo, 0, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	season, err := uc.StartSeason(ctx, "Season 1")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, "Season 1", season.Name)
+}
+
+func TestSeasonUseCase_StartSeason_WhenArchiveFails_ShouldNotResetCacheOrCreateSeason(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeasonRepo := mocks.NewMockSeasonRepository(ctrl)
+	mockSeasonRepo.EXPECT().GetActive(ctx).Return(&domain.Season{ID: "season-1"}, nil).Times(1)
+	mockSeasonRepo.EXPECT().Archive(ctx, "season-1", gomock.Any()).Return(domain.ErrNoActiveSeason).Times(1)
+	mockSeasonRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+	mockCacheRepo.EXPECT().Reset(gomock.Any()).Times(0)
+
+	uc := NewSeasonUseCase(mockSeasonRepo, mockCacheRepo, 0, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	season, err := uc.StartSeason(ctx, "Season 2")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrNoActiveSeason)
+	require.Nil(t, season)
+}
+
+func TestSeasonUseCase_EndSeason_WhenNoSeasonActive_ShouldReturnErrNoActiveSeason(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeasonRepo := mocks.NewMockSeasonRepository(ctrl)
+	mockSeasonRepo.EXPECT().GetActive(ctx).Return(nil, nil).Times(1)
+	mockSeasonRepo.EXPECT().Archive(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	mockCacheRepo := mocks.NewMockLeaderboardCacheRepository(ctrl)
+
+	uc := NewSeasonUseCase(mockSeasonRepo, mockCacheRepo, 0, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	season, err := uc.EndSeason(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrNoActiveSeason)
+	require.Nil(t, season)
+}
+
+func TestSeasonUseCase_GetBestRankEver_WhenUserHasStandings_ShouldReturnBestRank(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expected := &domain.BestRank{UserID: "user-1", Rank: 2, Score: 900, SeasonID: "season-1", SeasonName: "Season 1"}
+	mockSeasonRepo := mocks.NewMockSeasonRepository(ctrl)
+	mockSeasonRepo.EXPECT().GetBestRank(ctx, "user-1").Return(expected, nil).Times(1)
+
+	uc := NewSeasonUseCase(mockSeasonRepo, mocks.NewMockLeaderboardCacheRepository(ctrl), 0, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	best, err := uc.GetBestRankEver(ctx, "user-1")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, expected, best)
+}
+
+func TestSeasonUseCase_GetBestRankEver_WhenUserHasNoStandings_ShouldReturnErrNoSeasonStandings(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeasonRepo := mocks.NewMockSeasonRepository(ctrl)
+	mockSeasonRepo.EXPECT().GetBestRank(ctx, "user-1").Return(nil, nil).Times(1)
+
+	uc := NewSeasonUseCase(mockSeasonRepo, mocks.NewMockLeaderboardCacheRepository(ctrl), 0, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	best, err := uc.GetBestRankEver(ctx, "user-1")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrNoSeasonStandings)
+	require.Nil(t, best)
+}
+
+func TestSeasonUseCase_GetRankHistory_WhenEndOmitted_ShouldQueryUntilNow(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := []domain.RankPoint{
+		{SeasonID: "season-1", Rank: 3, Score: 900, Ranked: true},
+		{SeasonID: "season-2"},
+	}
+	mockSeasonRepo := mocks.NewMockSeasonRepository(ctrl)
+	mockSeasonRepo.EXPECT().GetRankHistory(ctx, "user-1", start, now).Return(expected, nil).Times(1)
+
+	uc := NewSeasonUseCase(mockSeasonRepo, mocks.NewMockLeaderboardCacheRepository(ctrl), 0, logger.New("info", false))
+	uc.now = func() time.Time { return now }
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	points, err := uc.GetRankHistory(ctx, "user-1", start, time.Time{})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, expected, points)
+}
+
+func TestSeasonUseCase_GetTopMovers_WhenTwoSeasonsEnded_ShouldRankClimbersAndFallers(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	firstEnd := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	secondEnd := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	first := &domain.Season{ID: "season-1", Name: "Season 1", EndedAt: &firstEnd}
+	second := &domain.Season{ID: "season-2", Name: "Season 2", EndedAt: &secondEnd}
+
+	mockSeasonRepo := mocks.NewMockSeasonRepository(ctrl)
+	mockSeasonRepo.EXPECT().GetLatestEnded(ctx).Return(second, nil).Times(1)
+	mockSeasonRepo.EXPECT().GetEndedNearest(ctx, secondEnd, secondEnd).Return(first, nil).Times(1)
+	mockSeasonRepo.EXPECT().GetStandings(ctx, "season-1").Return([]domain.SeasonStanding{
+		{UserID: "user-a", Rank: 1},
+		{UserID: "user-b", Rank: 2},
+		{UserID: "user-c", Rank: 3},
+		{UserID: "user-d", Rank: 4}, // drops off
+	}, nil).Times(1)
+	mockSeasonRepo.EXPECT().GetStandings(ctx, "season-2").Return([]domain.SeasonStanding{
+		{UserID: "user-c", Rank: 1},
+		{UserID: "user-a", Rank: 2},
+		{UserID: "user-e", Rank: 3}, // new this season
+		{UserID: "user-b", Rank: 4},
+	}, nil).Times(1)
+
+	uc := NewSeasonUseCase(mockSeasonRepo, mocks.NewMockLeaderboardCacheRepository(ctrl), 0, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	movers, err := uc.GetTopMovers(ctx, time.Time{}, 2)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, "season-1", movers.From.ID)
+	require.Equal(t, "season-2", movers.To.ID)
+	require.Equal(t, []domain.Mover{
+		{UserID: "user-c", PreviousRank: 3, CurrentRank: 1, Change: 2},
+		{UserID: "user-e", PreviousRank: 0, CurrentRank: 3, Change: 2},
+	}, movers.Climbers)
+	require.Equal(t, []domain.Mover{
+		{UserID: "user-b", PreviousRank: 2, CurrentRank: 4, Change: -2},
+		{UserID: "user-a", PreviousRank: 1, CurrentRank: 2, Change: -1},
+	}, movers.Fallers)
+}
+
+func TestSeasonUseCase_GetTopMovers_WhenOnlyOneSeasonEnded_ShouldReturnErrNotEnoughSeasons(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	end := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockSeasonRepo := mocks.NewMockSeasonRepository(ctrl)
+	mockSeasonRepo.EXPECT().GetLatestEnded(ctx).Return(&domain.Season{ID: "season-1", EndedAt: &end}, nil).Times(1)
+	mockSeasonRepo.EXPECT().GetEndedNearest(ctx, since, end).Return(nil, nil).Times(1)
+
+	uc := NewSeasonUseCase(mockSeasonRepo, mocks.NewMockLeaderboardCacheRepository(ctrl), 0, logger.New("info", false))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	movers, err := uc.GetTopMovers(ctx, since, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrNotEnoughSeasons)
+	require.Nil(t, movers)
+}
@@ -0,0 +1,13 @@
+package application
+
+import (
+	"context"
+	"time"
+)
+
+// SubmissionNonceRepository remembers the nonces of signed score submissions so a captured request cannot be replayed.
+// Nonces expire after their ttl, once a replay would be rejected as stale anyway.
+type SubmissionNonceRepository interface {
+	// Reserve records nonce for ttl and reports whether it was unused; false means a replay
+	Reserve(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+}
@@ -0,0 +1,17 @@
+package application
+
+//go:generate mockgen -destination=../infrastructure/mocks/submission_quota_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application SubmissionQuotaRepository
+
+import (
+	"context"
+	"time"
+)
+
+// SubmissionQuotaRepository counts each user's score submissions per UTC day.
+// Counters expire at the end of their day, so no cleanup job is needed.
+type SubmissionQuotaRepository interface {
+	// Increment counts a submission by the user on the UTC day containing at and returns that day's count
+	Increment(ctx context.Context, userID string, at time.Time) (int64, error)
+	// Decrement takes back a submission counted by Increment with the same at, e.g. because storing it failed
+	Decrement(ctx context.Context, userID string, at time.Time) error
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// usernameFallbackIDLength is how many leading characters of the user ID "{id}" expands to in UsernameConfig.Fallback
+const usernameFallbackIDLength = 8
+
+// UsernameConfig decides what enriched entries show when usernames cannot be loaded (zero value keeps defaults)
+type UsernameConfig struct {
+	// Fallback is the username of users whose username could not be loaded; "{id}" expands to the first
+	// 8 characters of the user ID, and empty leaves the username empty
+	Fallback string
+	// Required fails reads, and skips broadcasts, when the username lookup fails instead of using Fallback
+	Required bool
+}
+
+// fallbackFor returns the username shown for userID when its username could not be loaded
+func (c UsernameConfig) fallbackFor(userID string) string {
+	if !strings.Contains(c.Fallback, "{id}") {
+		return c.Fallback
+	}
+	short := userID
+	if len(short) > usernameFallbackIDLength {
+		short = short[:usernameFallbackIDLength]
+	}
+	return strings.ReplaceAll(c.Fallback, "{id}", short)
+}
+
+// lookupUsernames returns the username of every ID in userIDs, using the fallback for any that could not be loaded.
+// A failed lookup is logged and served with fallbacks, unless usernames are required, in which case it is returned.
+// On a context from WithoutUsernames nothing is looked up and an empty map is returned.
+func lookupUsernames(ctx context.Context, userRepo UserRepository, cfg UsernameConfig, l *logger.Logger, userIDs []string) (map[string]string, error) {
+	if UsernamesSkipped(ctx) {
+		return map[string]string{}, nil
+	}
+
+	usernames, err := userRepo.GetByIDs(ctx, userIDs)
+	if err != nil {
+		if cfg.Required {
+			l.Errorf(ctx, "Failed to enrich entries with usernames: %v", err)
+			return nil, fmt.Errorf("failed to retrieve usernames: %w", err)
+		}
+		l.Warnf(ctx, "Failed to enrich entries with usernames: %v", err)
+	}
+
+	result := make(map[string]string, len(userIDs))
+	for _, userID := range userIDs {
+		if username, ok := usernames[userID]; ok {
+			result[userID] = username
+			continue
+		}
+		if err == nil {
+			l.Warnf(ctx, "Username not found for user ID: %s", userID)
+		}
+		result[userID] = cfg.fallbackFor(userID)
+	}
+
+	return result, nil
+}
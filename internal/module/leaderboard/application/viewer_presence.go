@@ -0,0 +1,19 @@
+package application
+
+//go:generate mockgen -destination=../infrastructure/mocks/viewer_presence_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application ViewerPresenceRepository
+
+import (
+	"context"
+	"time"
+)
+
+// ViewerPresenceRepository tracks live stream connections so the number of current viewers can be shown.
+// Presence expires after ttl without a Touch, so viewers held by a crashed server disappear on their own.
+type ViewerPresenceRepository interface {
+	// Touch marks the connection present until ttl from now and returns the viewer count
+	Touch(ctx context.Context, connectionID string, ttl time.Duration) (int64, error)
+	// Leave removes the connection and returns the viewer count
+	Leave(ctx context.Context, connectionID string) (int64, error)
+	// Count returns the number of connections whose presence has not expired
+	Count(ctx context.Context) (int64, error)
+}
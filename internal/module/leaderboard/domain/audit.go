@@ -0,0 +1,23 @@
+// Package domain provides domain entities for the leaderboard module.
+package domain
+
+import "time"
+
+// ShadowReasonPrefix starts the reason of an accepted submission that an enforced check would have rejected
+const ShadowReasonPrefix = "shadow: "
+
+// AdminSetReason is the reason of a score an admin set directly, bypassing the submission checks
+const AdminSetReason = "set by admin"
+
+// ScoreAuditEntry records a single score submission or increment attempt, accepted or rejected.
+// For an increment, Delta is set and Score is the total it produced, or would have produced when rejected by the
+// score bounds; it is 0 when the increment failed before the total was known.
+type ScoreAuditEntry struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Score     int64     `json:"score"`
+	Delta     *int64    `json:"delta,omitempty"`
+	Accepted  bool      `json:"accepted"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
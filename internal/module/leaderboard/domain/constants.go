@@ -1,15 +1,73 @@
 // Package domain provides domain entities and constants for the leaderboard module.
 package domain
 
+import "time"
+
 const (
-	// RedisViewerUpdateTopic is the Redis pub/sub topic published with leaderboard entry delta updates for viewers.
+	// GlobalBoardID identifies the single global leaderboard in events sent to external systems.
+	GlobalBoardID = "global"
+
+	// RedisViewerUpdateTopic is the Redis pub/sub topic published with leaderboard entry delta updates for viewers,
+	// and with the current viewer count whenever a viewer joins or leaves or the count changes.
 	RedisViewerUpdateTopic = "leaderboard:viewer:updates"
 
+	// RedisViewerPresenceKey is the Redis sorted set of live stream connection IDs scored by presence expiry (unix ms).
+	RedisViewerPresenceKey = "leaderboard:global:viewers"
+
 	// RedisLeaderboardKey is the Redis sorted set key for the global leaderboard.
 	RedisLeaderboardKey = "leaderboard:global"
 
+	// RedisLeaderboardVersionKey is the Redis counter incremented whenever the global leaderboard's scores change.
+	RedisLeaderboardVersionKey = "leaderboard:global:version"
+
+	// RedisLeaderboardUpdatedAtKey is the Redis string holding when the version last changed (unix ms, Redis clock).
+	RedisLeaderboardUpdatedAtKey = "leaderboard:global:updated_at"
+
+	// RedisLeaderboardLoadedKey marks the global leaderboard as loaded from persistence. It expires with the board,
+	// so writes that recreate an expired board leave it missing and reads reload the board instead of serving it.
+	RedisLeaderboardLoadedKey = "leaderboard:global:loaded"
+
+	// RedisBelowBoardMinimumKey is the Redis sorted set of best scores below the board minimum, kept off the
+	// global leaderboard but still used to keep each user's best.
+	RedisBelowBoardMinimumKey = "leaderboard:global:below_minimum"
+
+	// RedisJobLeaderKey is the Redis lease key held by the one instance allowed to run background jobs.
+	RedisJobLeaderKey = "leaderboard:jobs:leader"
+
+	// RedisLastActivityKey is the Redis sorted set key scoring each user ID by the unix time of their latest score submission.
+	RedisLastActivityKey = "leaderboard:global:activity"
+
+	// RedisSubmissionQuotaKeyPrefix prefixes the Redis counters of score submissions per UTC day and user
+	// (leaderboard:quota:<YYYY-MM-DD>:<userID>), which expire at the end of their day.
+	RedisSubmissionQuotaKeyPrefix = "leaderboard:quota:"
+
+	// RedisSubmissionNonceKeyPrefix prefixes the Redis keys of nonces already used by signed score submissions
+	// (leaderboard:nonce:<nonce>), which expire once the signature would be stale.
+	RedisSubmissionNonceKeyPrefix = "leaderboard:nonce:"
+
+	// RedisUsernameKeyPrefix prefixes the Redis string keys caching each user's username by user ID.
+	RedisUsernameKeyPrefix = "leaderboard:username:"
+
 	// MaxBroadcastRank is the maximum rank for which entry updates are broadcasted.
 	// Entries ranked higher than this will not trigger broadcasts to reduce unnecessary network traffic.
 	// This threshold should be higher than any client's typical limit (e.g., 1000 covers clients showing top 5/10/50/100).
 	MaxBroadcastRank = 1000
+
+	// MaxSafeScore is the largest score stored exactly: Redis sorted set scores are float64,
+	// which cannot represent every integer above 2^53.
+	MaxSafeScore int64 = 1 << 53
+)
+
+const (
+	// ViewerHeartbeatInterval is how often a live stream refreshes its presence.
+	ViewerHeartbeatInterval = 15 * time.Second
+
+	// ViewerPresenceTTL is how long a presence survives without a heartbeat, so crashed servers stop counting viewers.
+	ViewerPresenceTTL = 3 * ViewerHeartbeatInterval
+
+	// VersionPollInterval is how often a long-poll request re-reads the board version while waiting for a change.
+	VersionPollInterval = 500 * time.Millisecond
+
+	// JobLeaderLeaseTTL is how long the background job lease survives without renewal before another instance takes over.
+	JobLeaderLeaseTTL = 15 * time.Second
 )
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Domain errors for leaderboard module
+var (
+	ErrUserNotInLeaderboard    = errors.New("user not found in leaderboard")
+	ErrEmailNotVerified        = errors.New("email not verified")
+	ErrScoreTooHigh            = errors.New("score exceeds the maximum allowed value")
+	ErrScoreBelowMinimum       = errors.New("score falls below the minimum allowed value")
+	ErrZeroScoreNotAllowed     = errors.New("score must be greater than 0")
+	ErrSubmissionQuotaExceeded = errors.New("daily score submission quota exceeded")
+	ErrNoActiveSeason          = errors.New("no active season")
+	ErrSeasonAlreadyActive     = errors.New("a season is already active")
+	ErrNoSeasonStandings       = errors.New("user has no archived season standings")
+	ErrNotEnoughSeasons        = errors.New("at least two ended seasons are needed to compare standings")
+	ErrScoreResetDisabled      = errors.New("score reset is disabled")
+	// ErrScoreNotCached is returned by unseeded cache writes for a user the cache does not hold
+	ErrScoreNotCached = errors.New("score is not in the cached leaderboard")
+	// ErrLeaderboardNotLoaded is returned by cache writes while the board is not loaded from persistence
+	ErrLeaderboardNotLoaded = errors.New("cached leaderboard is not loaded")
+)
+
+// SubmissionQuotaError reports a user who used up their daily score submissions; it matches ErrSubmissionQuotaExceeded
+type SubmissionQuotaError struct {
+	Limit   int64
+	ResetAt time.Time
+}
+
+func (e *SubmissionQuotaError) Error() string {
+	return fmt.Sprintf("%v: %d per day", ErrSubmissionQuotaExceeded, e.Limit)
+}
+
+func (e *SubmissionQuotaError) Unwrap() error {
+	return ErrSubmissionQuotaExceeded
+}
@@ -1,10 +1,132 @@
 // Package domain provides domain entities for the leaderboard module.
 package domain
 
+import "time"
+
+// SortOrder decides which end of the score range ranks first
+type SortOrder string
+
+const (
+	// SortOrderDesc ranks the highest score first (default)
+	SortOrderDesc SortOrder = "desc"
+	// SortOrderAsc ranks the lowest score first, e.g. when the fastest time wins
+	SortOrderAsc SortOrder = "asc"
+)
+
 // LeaderboardEntry represents a leaderboard entry
 type LeaderboardEntry struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
 	Score    int64  `json:"score"`
 	Rank     int64  `json:"rank"`
+	// ScoreDisplay is the abbreviated score (e.g. "1.2K"), only set when the client asks for it
+	ScoreDisplay string `json:"score_display,omitempty"`
+	// UpdatedAt is when the user's persisted score last changed, only set when the client asks for it
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// UserRankEntry is the result of looking up a specific user's rank.
+// InLeaderboard is false, with zero score and rank, when the user is not on the board.
+type UserRankEntry struct {
+	LeaderboardEntry
+	InLeaderboard bool `json:"in_leaderboard"`
+}
+
+// GapInfo is how far a ranked user is from the player directly above them
+type GapInfo struct {
+	UserID string `json:"user_id"`
+	Score  int64  `json:"score"`
+	Rank   int64  `json:"rank"`
+	// IsLeader is true when the user holds rank 1, leaving nobody to catch up with
+	IsLeader bool `json:"is_leader"`
+	// ScoreGap is the score difference to the player at rank-1 (0 for the leader or a tie)
+	ScoreGap int64 `json:"score_gap"`
+}
+
+// Standing is a ranked user's entry together with the players ranked directly around them, best first.
+// InLeaderboard is false, with no entries, when the user is not on the board.
+type Standing struct {
+	InLeaderboard bool               `json:"in_leaderboard"`
+	Self          *LeaderboardEntry  `json:"self"`
+	Above         []LeaderboardEntry `json:"above"`
+	Below         []LeaderboardEntry `json:"below"`
+}
+
+// ScoreBucket is one bar of the score distribution: the number of players scoring within [Min, Max]
+type ScoreBucket struct {
+	Min   int64 `json:"min"`
+	Max   int64 `json:"max"`
+	Count int64 `json:"count"`
+}
+
+// NewScoreBuckets splits the score range [lowest, highest] into at most n buckets of equal width.
+// Fewer buckets are returned when the range has fewer distinct integer scores than n.
+func NewScoreBuckets(lowest, highest int64, n int) []ScoreBucket {
+	span := highest - lowest + 1
+	if n < 1 || span < 1 {
+		return []ScoreBucket{}
+	}
+
+	width := (span + int64(n) - 1) / int64(n)
+	buckets := make([]ScoreBucket, 0, n)
+	for start := lowest; start <= highest; start += width {
+		buckets = append(buckets, ScoreBucket{Min: start, Max: min(start+width-1, highest)})
+	}
+	return buckets
+}
+
+// ScoreSubmission is the outcome of submitting a score to the cached board
+type ScoreSubmission struct {
+	// Rank is the user's 1-based rank after the submission (0 when their best is below the board minimum)
+	Rank int64
+	// Improved is false when the score did not beat the user's best, leaving the board unchanged
+	Improved bool
+	// IsNewLeader is true when the submission moved the user into rank 1
+	IsNewLeader bool
+}
+
+// ScoreIncrement is the outcome of adding a delta to a user's score on the cached board
+type ScoreIncrement struct {
+	// Score is the user's total after the increment, or the total it would have reached when not applied
+	Score int64
+	// Rank is the user's 1-based rank after the increment (0 when not applied or below the board minimum)
+	Rank int64
+	// Applied is false when the new total fell outside the allowed range, leaving the board unchanged
+	Applied bool
+	// IsNewLeader is true when the increment moved the user into rank 1
+	IsNewLeader bool
+}
+
+// ScoreSeed is a user's persisted score, handed to a cached write for a user the cache no longer holds (e.g.
+// after the inactivity sweeper evicted them) so the write builds on it instead of starting over
+type ScoreSeed struct {
+	// Score is the persisted score; ignored when Found is false
+	Score int64
+	// Found is false when the user has no persisted score, so the write starts from none
+	Found bool
+}
+
+// StreamUpdate is one message for stream subscribers; exactly one of Entry and Viewers is set
+type StreamUpdate struct {
+	// Entry is a leaderboard entry delta update
+	Entry *LeaderboardEntry
+	// Viewers is the current number of stream viewers
+	Viewers *int64
+}
+
+// BroadcastStatus reports how recently this instance published an entry update, to detect a stalled broadcaster
+type BroadcastStatus struct {
+	// LastPublishAt is omitted until the first successful publish
+	LastPublishAt time.Time `json:"last_publish_at,omitzero"`
+	// LagSeconds is the time since LastPublishAt (0 until the first successful publish)
+	LagSeconds float64 `json:"lag_seconds"`
+}
+
+// NewLeaderEvent is emitted when a score submission moves a user into rank 1
+type NewLeaderEvent struct {
+	UserID   string    `json:"user_id"`
+	Username string    `json:"username"`
+	Score    int64     `json:"score"`
+	BoardID  string    `json:"board_id"`
+	At       time.Time `json:"at"`
 }
@@ -0,0 +1,62 @@
+// Package domain provides domain entities for the leaderboard module.
+package domain
+
+import "time"
+
+// Season is a period of play whose final standings are archived when it ends
+type Season struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+}
+
+// IsActive reports whether the season has not ended yet
+func (s Season) IsActive() bool {
+	return s.EndedAt == nil
+}
+
+// BestRank is the best (numerically lowest) final rank a user held across ended seasons
+type BestRank struct {
+	UserID     string    `json:"user_id"`
+	Rank       int64     `json:"rank"`
+	Score      int64     `json:"score"`
+	SeasonID   string    `json:"season_id"`
+	SeasonName string    `json:"season_name"`
+	At         time.Time `json:"at"`
+}
+
+// RankPoint is a user's final rank in one ended season; Ranked is false for seasons the user did not place in
+type RankPoint struct {
+	SeasonID   string    `json:"season_id"`
+	SeasonName string    `json:"season_name"`
+	At         time.Time `json:"at"`
+	Rank       int64     `json:"rank"`
+	Score      int64     `json:"score"`
+	Ranked     bool      `json:"ranked"`
+}
+
+// SeasonStanding is a user's archived final rank and score in one season
+type SeasonStanding struct {
+	UserID string `json:"user_id"`
+	Rank   int64  `json:"rank"`
+	Score  int64  `json:"score"`
+}
+
+// Mover is a user's change in final rank between two ended seasons.
+// PreviousRank is 0 for players new to the later season and CurrentRank is 0 for players who dropped off;
+// Change (positive when climbing) counts a missing rank as one place below the last player of that season.
+type Mover struct {
+	UserID       string `json:"user_id"`
+	PreviousRank int64  `json:"previous_rank"`
+	CurrentRank  int64  `json:"current_rank"`
+	Change       int64  `json:"change"`
+}
+
+// TopMovers lists the biggest rank climbers and fallers between two ended seasons
+type TopMovers struct {
+	From     Season  `json:"from"`
+	To       Season  `json:"to"`
+	Climbers []Mover `json:"climbers"`
+	Fallers  []Mover `json:"fallers"`
+}
@@ -0,0 +1,51 @@
+package broadcast
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// envelopeVersion is the current pub/sub message schema version
+const envelopeVersion = 1
+
+// Pub/sub message types
+const (
+	messageTypeEntryUpdate = "entry_update"
+	messageTypeViewerCount = "viewer_count"
+)
+
+// viewerCountPayload is the payload of a viewer_count message
+type viewerCountPayload struct {
+	Viewers int64 `json:"viewers"`
+}
+
+// envelope wraps every pub/sub message so new message types can share a topic
+// and subscribers can skip the ones they do not understand
+type envelope struct {
+	Type    string          `json:"type"`
+	Version int             `json:"version"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// encodeEnvelope marshals payload into a versioned envelope of the given type
+func encodeEnvelope(msgType string, payload interface{}) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s payload: %w", msgType, err)
+	}
+
+	return json.Marshal(envelope{
+		Type:    msgType,
+		Version: envelopeVersion,
+		Payload: data,
+	})
+}
+
+// decodeEnvelope unmarshals a pub/sub message into its envelope
+func decodeEnvelope(data []byte) (*envelope, error) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal envelope: %w", err)
+	}
+	return &env, nil
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"real-time-leaderboard/internal/module/leaderboard/application"
 	"real-time-leaderboard/internal/module/leaderboard/domain"
@@ -15,9 +17,11 @@ import (
 
 // RedisBroadcastService implements BroadcastService using Redis pub/sub
 type RedisBroadcastService struct {
-	client     *redis.Client
-	logger     *logger.Logger
+	client      *redis.Client
+	logger      *logger.Logger
 	viewerTopic string
+	// lastEntryPublish is the Unix time in nanoseconds of the last successful entry update publish (0 if none)
+	lastEntryPublish atomic.Int64
 }
 
 // NewRedisBroadcastService creates a new Redis broadcast service
@@ -34,18 +38,48 @@ func NewRedisBroadcastService(
 
 // BroadcastEntryUpdate broadcasts a leaderboard entry delta update to all subscribers
 func (s *RedisBroadcastService) BroadcastEntryUpdate(ctx context.Context, entry *domain.LeaderboardEntry) error {
-	jsonData, err := json.Marshal(entry)
+	jsonData, err := encodeEnvelope(messageTypeEntryUpdate, entry)
 	if err != nil {
-		return fmt.Errorf("failed to marshal entry: %w", err)
+		return err
+	}
+
+	if err := s.client.Publish(ctx, s.viewerTopic, jsonData).Err(); err != nil {
+		return err
+	}
+
+	s.lastEntryPublish.Store(time.Now().UnixNano())
+	return nil
+}
+
+// LastEntryPublishAt returns when this instance last published an entry update, or the zero time if it never has
+func (s *RedisBroadcastService) LastEntryPublishAt() time.Time {
+	nanos := s.lastEntryPublish.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
+// BroadcastViewerCount publishes the current viewer count on the viewer topic as a viewer_count message
+func (s *RedisBroadcastService) BroadcastViewerCount(ctx context.Context, count int64) error {
+	jsonData, err := encodeEnvelope(messageTypeViewerCount, viewerCountPayload{Viewers: count})
+	if err != nil {
+		return err
 	}
 
 	return s.client.Publish(ctx, s.viewerTopic, jsonData).Err()
 }
 
-// SubscribeToEntryUpdates subscribes to leaderboard entry delta update broadcasts
-func (s *RedisBroadcastService) SubscribeToEntryUpdates(ctx context.Context) (<-chan *domain.LeaderboardEntry, error) {
+// SubscribeToStreamUpdates subscribes to leaderboard entry delta updates and viewer counts.
+// It waits for Redis to confirm the subscription, so an unreachable Redis is reported as an error
+// instead of a channel that never delivers.
+func (s *RedisBroadcastService) SubscribeToStreamUpdates(ctx context.Context) (<-chan domain.StreamUpdate, error) {
 	pubsub := s.client.Subscribe(ctx, s.viewerTopic)
-	ch := make(chan *domain.LeaderboardEntry, 1)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe to stream updates: %w", err)
+	}
+	ch := make(chan domain.StreamUpdate, 1)
 
 	go func() {
 		defer close(ch)
@@ -62,14 +96,34 @@ func (s *RedisBroadcastService) SubscribeToEntryUpdates(ctx context.Context) (<-
 					return
 				}
 
-				var entry domain.LeaderboardEntry
-				if err := json.Unmarshal([]byte(msg.Payload), &entry); err != nil {
-					s.logger.Warnf(ctx, "Failed to unmarshal entry: %v", err)
+				env, err := decodeEnvelope([]byte(msg.Payload))
+				if err != nil {
+					s.logger.Warnf(ctx, "Failed to decode message: %v", err)
+					continue
+				}
+				var update domain.StreamUpdate
+				switch env.Type {
+				case messageTypeEntryUpdate:
+					var entry domain.LeaderboardEntry
+					if err := json.Unmarshal(env.Payload, &entry); err != nil {
+						s.logger.Warnf(ctx, "Failed to unmarshal entry: %v", err)
+						continue
+					}
+					update.Entry = &entry
+				case messageTypeViewerCount:
+					var payload viewerCountPayload
+					if err := json.Unmarshal(env.Payload, &payload); err != nil {
+						s.logger.Warnf(ctx, "Failed to unmarshal viewer count: %v", err)
+						continue
+					}
+					update.Viewers = &payload.Viewers
+				default:
+					// Skip message types this subscriber does not understand
 					continue
 				}
 
 				select {
-				case ch <- &entry:
+				case ch <- update:
 				case <-ctx.Done():
 					return
 				}
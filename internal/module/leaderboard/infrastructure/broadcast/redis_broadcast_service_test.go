@@ -0,0 +1,146 @@
+package broadcast
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+func newTestBroadcastService(t *testing.T) (*RedisBroadcastService, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	return NewRedisBroadcastService(client, logger.New("info", false)).(*RedisBroadcastService), mr
+}
+
+func TestEnvelope_WhenEncodedAndDecoded_ShouldRoundTripPayload(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	entry := &domain.LeaderboardEntry{UserID: "user-1", Username: "alice", Score: 120, Rank: 1}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	data, err := encodeEnvelope(messageTypeEntryUpdate, entry)
+	require.NoError(t, err)
+	env, err := decodeEnvelope(data)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, messageTypeEntryUpdate, env.Type)
+	require.Equal(t, envelopeVersion, env.Version)
+
+	var decoded domain.LeaderboardEntry
+	require.NoError(t, json.Unmarshal(env.Payload, &decoded))
+	require.Equal(t, *entry, decoded)
+}
+
+func TestRedisBroadcastService_SubscribeToStreamUpdates_WhenUnknownTypePublished_ShouldSkipIt(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	svc, mr := newTestBroadcastService(t)
+
+	ch, err := svc.SubscribeToStreamUpdates(ctx)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return mr.PubSubNumSub(domain.RedisViewerUpdateTopic)[domain.RedisViewerUpdateTopic] == 1
+	}, time.Second, 10*time.Millisecond)
+
+	unknown, err := encodeEnvelope("leader_changed", map[string]string{"user_id": "user-2"})
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	mr.Publish(domain.RedisViewerUpdateTopic, string(unknown))
+	require.NoError(t, svc.BroadcastEntryUpdate(ctx, &domain.LeaderboardEntry{UserID: "user-1", Score: 50, Rank: 1}))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	select {
+	case update := <-ch:
+		require.NotNil(t, update.Entry)
+		require.Equal(t, "user-1", update.Entry.UserID)
+		require.Equal(t, int64(50), update.Entry.Score)
+	case <-ctx.Done():
+		t.Fatal("expected the entry update to be delivered")
+	}
+}
+
+func TestRedisBroadcastService_BroadcastEntryUpdate_WhenPublished_ShouldAdvanceLastPublishTime(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	svc, _ := newTestBroadcastService(t)
+	entry := &domain.LeaderboardEntry{UserID: "user-1", Username: "alice", Score: 120, Rank: 1}
+	require.True(t, svc.LastEntryPublishAt().IsZero())
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	require.NoError(t, svc.BroadcastEntryUpdate(ctx, entry))
+	first := svc.LastEntryPublishAt()
+	time.Sleep(time.Millisecond)
+	require.NoError(t, svc.BroadcastEntryUpdate(ctx, entry))
+	second := svc.LastEntryPublishAt()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.False(t, first.IsZero())
+	require.True(t, second.After(first))
+}
+
+func TestRedisBroadcastService_BroadcastEntryUpdate_WhenPublishFails_ShouldKeepLastPublishTime(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	svc, mr := newTestBroadcastService(t)
+	entry := &domain.LeaderboardEntry{UserID: "user-1", Username: "alice", Score: 120, Rank: 1}
+	require.NoError(t, svc.BroadcastEntryUpdate(ctx, entry))
+	before := svc.LastEntryPublishAt()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	mr.Close()
+	err := svc.BroadcastEntryUpdate(ctx, entry)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Equal(t, before, svc.LastEntryPublishAt())
+}
+
+func TestRedisBroadcastService_SubscribeToStreamUpdates_WhenRedisStopped_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	svc, mr := newTestBroadcastService(t)
+	mr.Close()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	ch, err := svc.SubscribeToStreamUpdates(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Nil(t, ch)
+}
+
+func TestRedisBroadcastService_BroadcastViewerCount_WhenSubscribed_ShouldDeliverCountOnViewerTopic(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	svc, _ := newTestBroadcastService(t)
+
+	ch, err := svc.SubscribeToStreamUpdates(ctx)
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = svc.BroadcastViewerCount(ctx, 3)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	select {
+	case update := <-ch:
+		require.Nil(t, update.Entry)
+		require.NotNil(t, update.Viewers)
+		require.Equal(t, int64(3), *update.Viewers)
+	case <-ctx.Done():
+		t.Fatal("expected the viewer count to be delivered")
+	}
+}
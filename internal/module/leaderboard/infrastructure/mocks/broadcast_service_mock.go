@@ -13,6 +13,7 @@ import (
 	context "context"
 	domain "real-time-leaderboard/internal/module/leaderboard/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -55,17 +56,45 @@ func (mr *MockBroadcastServiceMockRecorder) BroadcastEntryUpdate(ctx, entry any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastEntryUpdate", reflect.TypeOf((*MockBroadcastService)(nil).BroadcastEntryUpdate), ctx, entry)
 }
 
-// SubscribeToEntryUpdates mocks base method.
-func (m *MockBroadcastService) SubscribeToEntryUpdates(ctx context.Context) (<-chan *domain.LeaderboardEntry, error) {
+// BroadcastViewerCount mocks base method.
+func (m *MockBroadcastService) BroadcastViewerCount(ctx context.Context, count int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubscribeToEntryUpdates", ctx)
-	ret0, _ := ret[0].(<-chan *domain.LeaderboardEntry)
+	ret := m.ctrl.Call(m, "BroadcastViewerCount", ctx, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BroadcastViewerCount indicates an expected call of BroadcastViewerCount.
+func (mr *MockBroadcastServiceMockRecorder) BroadcastViewerCount(ctx, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastViewerCount", reflect.TypeOf((*MockBroadcastService)(nil).BroadcastViewerCount), ctx, count)
+}
+
+// LastEntryPublishAt mocks base method.
+func (m *MockBroadcastService) LastEntryPublishAt() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastEntryPublishAt")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// LastEntryPublishAt indicates an expected call of LastEntryPublishAt.
+func (mr *MockBroadcastServiceMockRecorder) LastEntryPublishAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastEntryPublishAt", reflect.TypeOf((*MockBroadcastService)(nil).LastEntryPublishAt))
+}
+
+// SubscribeToStreamUpdates mocks base method.
+func (m *MockBroadcastService) SubscribeToStreamUpdates(ctx context.Context) (<-chan domain.StreamUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToStreamUpdates", ctx)
+	ret0, _ := ret[0].(<-chan domain.StreamUpdate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SubscribeToEntryUpdates indicates an expected call of SubscribeToEntryUpdates.
-func (mr *MockBroadcastServiceMockRecorder) SubscribeToEntryUpdates(ctx any) *gomock.Call {
+// SubscribeToStreamUpdates indicates an expected call of SubscribeToStreamUpdates.
+func (mr *MockBroadcastServiceMockRecorder) SubscribeToStreamUpdates(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToEntryUpdates", reflect.TypeOf((*MockBroadcastService)(nil).SubscribeToEntryUpdates), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToStreamUpdates", reflect.TypeOf((*MockBroadcastService)(nil).SubscribeToStreamUpdates), ctx)
 }
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: real-time-leaderboard/internal/module/leaderboard/application (interfaces: LeaderNotifier)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../infrastructure/mocks/leader_notifier_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application LeaderNotifier
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "real-time-leaderboard/internal/module/leaderboard/domain"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLeaderNotifier is a mock of LeaderNotifier interface.
+type MockLeaderNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaderNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockLeaderNotifierMockRecorder is the mock recorder for MockLeaderNotifier.
+type MockLeaderNotifierMockRecorder struct {
+	mock *MockLeaderNotifier
+}
+
+// NewMockLeaderNotifier creates a new mock instance.
+func NewMockLeaderNotifier(ctrl *gomock.Controller) *MockLeaderNotifier {
+	mock := &MockLeaderNotifier{ctrl: ctrl}
+	mock.recorder = &MockLeaderNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaderNotifier) EXPECT() *MockLeaderNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyNewLeader mocks base method.
+func (m *MockLeaderNotifier) NotifyNewLeader(ctx context.Context, event *domain.NewLeaderEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyNewLeader", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyNewLeader indicates an expected call of NotifyNewLeader.
+func (mr *MockLeaderNotifierMockRecorder) NotifyNewLeader(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewLeader", reflect.TypeOf((*MockLeaderNotifier)(nil).NotifyNewLeader), ctx, event)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: real-time-leaderboard/internal/module/leaderboard/application (interfaces: UserRepository,LeaderboardPersistenceRepository,LeaderboardCacheRepository)
+// Source: real-time-leaderboard/internal/module/leaderboard/application (interfaces: UserRepository,LeaderboardPersistenceRepository,LeaderboardCacheRepository,ScoreAuditRepository,SeasonRepository)
 //
 // Generated by this command:
 //
-//	mockgen -destination=../infrastructure/mocks/repository_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application UserRepository,LeaderboardPersistenceRepository,LeaderboardCacheRepository
+//	mockgen -destination=../infrastructure/mocks/repository_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application UserRepository,LeaderboardPersistenceRepository,LeaderboardCacheRepository,ScoreAuditRepository,SeasonRepository
 //
 
 // Package mocks is a generated GoMock package.
@@ -13,6 +13,7 @@ import (
 	context "context"
 	domain "real-time-leaderboard/internal/module/leaderboard/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -56,6 +57,21 @@ func (mr *MockUserRepositoryMockRecorder) GetByIDs(ctx, userIDs any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockUserRepository)(nil).GetByIDs), ctx, userIDs)
 }
 
+// IsEmailVerified mocks base method.
+func (m *MockUserRepository) IsEmailVerified(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEmailVerified", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsEmailVerified indicates an expected call of IsEmailVerified.
+func (mr *MockUserRepositoryMockRecorder) IsEmailVerified(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEmailVerified", reflect.TypeOf((*MockUserRepository)(nil).IsEmailVerified), ctx, userID)
+}
+
 // MockLeaderboardPersistenceRepository is a mock of LeaderboardPersistenceRepository interface.
 type MockLeaderboardPersistenceRepository struct {
 	ctrl     *gomock.Controller
@@ -80,6 +96,20 @@ func (m *MockLeaderboardPersistenceRepository) EXPECT() *MockLeaderboardPersiste
 	return m.recorder
 }
 
+// DeleteScore mocks base method.
+func (m *MockLeaderboardPersistenceRepository) DeleteScore(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScore", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScore indicates an expected call of DeleteScore.
+func (mr *MockLeaderboardPersistenceRepositoryMockRecorder) DeleteScore(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScore", reflect.TypeOf((*MockLeaderboardPersistenceRepository)(nil).DeleteScore), ctx, userID)
+}
+
 // GetLeaderboard mocks base method.
 func (m *MockLeaderboardPersistenceRepository) GetLeaderboard(ctx context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +126,96 @@ func (mr *MockLeaderboardPersistenceRepositoryMockRecorder) GetLeaderboard(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*MockLeaderboardPersistenceRepository)(nil).GetLeaderboard), ctx, limit, offset)
 }
 
+// GetScore mocks base method.
+func (m *MockLeaderboardPersistenceRepository) GetScore(ctx context.Context, userID string) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScore", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetScore indicates an expected call of GetScore.
+func (mr *MockLeaderboardPersistenceRepositoryMockRecorder) GetScore(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScore", reflect.TypeOf((*MockLeaderboardPersistenceRepository)(nil).GetScore), ctx, userID)
+}
+
+// GetTotalPlayers mocks base method.
+func (m *MockLeaderboardPersistenceRepository) GetTotalPlayers(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPlayers", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPlayers indicates an expected call of GetTotalPlayers.
+func (mr *MockLeaderboardPersistenceRepositoryMockRecorder) GetTotalPlayers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPlayers", reflect.TypeOf((*MockLeaderboardPersistenceRepository)(nil).GetTotalPlayers), ctx)
+}
+
+// GetUpdatedTimes mocks base method.
+func (m *MockLeaderboardPersistenceRepository) GetUpdatedTimes(ctx context.Context, userIDs []string) (map[string]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdatedTimes", ctx, userIDs)
+	ret0, _ := ret[0].(map[string]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpdatedTimes indicates an expected call of GetUpdatedTimes.
+func (mr *MockLeaderboardPersistenceRepositoryMockRecorder) GetUpdatedTimes(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdatedTimes", reflect.TypeOf((*MockLeaderboardPersistenceRepository)(nil).GetUpdatedTimes), ctx, userIDs)
+}
+
+// GetUserEntry mocks base method.
+func (m *MockLeaderboardPersistenceRepository) GetUserEntry(ctx context.Context, userID string) (*domain.LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEntry", ctx, userID)
+	ret0, _ := ret[0].(*domain.LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEntry indicates an expected call of GetUserEntry.
+func (mr *MockLeaderboardPersistenceRepositoryMockRecorder) GetUserEntry(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEntry", reflect.TypeOf((*MockLeaderboardPersistenceRepository)(nil).GetUserEntry), ctx, userID)
+}
+
+// IncrementScore mocks base method.
+func (m *MockLeaderboardPersistenceRepository) IncrementScore(ctx context.Context, userID string, delta int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementScore", ctx, userID, delta)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementScore indicates an expected call of IncrementScore.
+func (mr *MockLeaderboardPersistenceRepositoryMockRecorder) IncrementScore(ctx, userID, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementScore", reflect.TypeOf((*MockLeaderboardPersistenceRepository)(nil).IncrementScore), ctx, userID, delta)
+}
+
+// SetScore mocks base method.
+func (m *MockLeaderboardPersistenceRepository) SetScore(ctx context.Context, userID string, score int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScore", ctx, userID, score)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetScore indicates an expected call of SetScore.
+func (mr *MockLeaderboardPersistenceRepositoryMockRecorder) SetScore(ctx, userID, score any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScore", reflect.TypeOf((*MockLeaderboardPersistenceRepository)(nil).SetScore), ctx, userID, score)
+}
+
 // UpsertScore mocks base method.
 func (m *MockLeaderboardPersistenceRepository) UpsertScore(ctx context.Context, userID string, score int64) error {
 	m.ctrl.T.Helper()
@@ -134,6 +254,21 @@ func (m *MockLeaderboardCacheRepository) EXPECT() *MockLeaderboardCacheRepositor
 	return m.recorder
 }
 
+// CountScores mocks base method.
+func (m *MockLeaderboardCacheRepository) CountScores(ctx context.Context, buckets []domain.ScoreBucket) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountScores", ctx, buckets)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountScores indicates an expected call of CountScores.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) CountScores(ctx, buckets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountScores", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).CountScores), ctx, buckets)
+}
+
 // GetLeaderboard mocks base method.
 func (m *MockLeaderboardCacheRepository) GetLeaderboard(ctx context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error) {
 	m.ctrl.T.Helper()
@@ -150,6 +285,83 @@ func (mr *MockLeaderboardCacheRepositoryMockRecorder) GetLeaderboard(ctx, limit,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).GetLeaderboard), ctx, limit, offset)
 }
 
+// GetScoreBounds mocks base method.
+func (m *MockLeaderboardCacheRepository) GetScoreBounds(ctx context.Context) (int64, int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScoreBounds", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetScoreBounds indicates an expected call of GetScoreBounds.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) GetScoreBounds(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScoreBounds", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).GetScoreBounds), ctx)
+}
+
+// GetTotalPlayers mocks base method.
+func (m *MockLeaderboardCacheRepository) GetTotalPlayers(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPlayers", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPlayers indicates an expected call of GetTotalPlayers.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) GetTotalPlayers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPlayers", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).GetTotalPlayers), ctx)
+}
+
+// GetUpdatedAt mocks base method.
+func (m *MockLeaderboardCacheRepository) GetUpdatedAt(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdatedAt", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpdatedAt indicates an expected call of GetUpdatedAt.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) GetUpdatedAt(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdatedAt", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).GetUpdatedAt), ctx)
+}
+
+// GetUserEntries mocks base method.
+func (m *MockLeaderboardCacheRepository) GetUserEntries(ctx context.Context, userIDs []string) (map[string]domain.LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEntries", ctx, userIDs)
+	ret0, _ := ret[0].(map[string]domain.LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEntries indicates an expected call of GetUserEntries.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) GetUserEntries(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEntries", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).GetUserEntries), ctx, userIDs)
+}
+
+// GetUserEntry mocks base method.
+func (m *MockLeaderboardCacheRepository) GetUserEntry(ctx context.Context, userID string) (*domain.LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEntry", ctx, userID)
+	ret0, _ := ret[0].(*domain.LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEntry indicates an expected call of GetUserEntry.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) GetUserEntry(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEntry", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).GetUserEntry), ctx, userID)
+}
+
 // GetUserRank mocks base method.
 func (m *MockLeaderboardCacheRepository) GetUserRank(ctx context.Context, userID string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -165,6 +377,152 @@ func (mr *MockLeaderboardCacheRepositoryMockRecorder) GetUserRank(ctx, userID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRank", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).GetUserRank), ctx, userID)
 }
 
+// GetVersion mocks base method.
+func (m *MockLeaderboardCacheRepository) GetVersion(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) GetVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).GetVersion), ctx)
+}
+
+// IncrementAndRank mocks base method.
+func (m *MockLeaderboardCacheRepository) IncrementAndRank(ctx context.Context, userID string, delta, minScore, maxScore int64, seed *domain.ScoreSeed) (*domain.ScoreIncrement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementAndRank", ctx, userID, delta, minScore, maxScore, seed)
+	ret0, _ := ret[0].(*domain.ScoreIncrement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementAndRank indicates an expected call of IncrementAndRank.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) IncrementAndRank(ctx, userID, delta, minScore, maxScore, seed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementAndRank", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).IncrementAndRank), ctx, userID, delta, minScore, maxScore, seed)
+}
+
+// IsLoaded mocks base method.
+func (m *MockLeaderboardCacheRepository) IsLoaded(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLoaded", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsLoaded indicates an expected call of IsLoaded.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) IsLoaded(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLoaded", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).IsLoaded), ctx)
+}
+
+// MarkLoaded mocks base method.
+func (m *MockLeaderboardCacheRepository) MarkLoaded(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLoaded", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkLoaded indicates an expected call of MarkLoaded.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) MarkLoaded(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLoaded", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).MarkLoaded), ctx)
+}
+
+// RemoveInactiveUsers mocks base method.
+func (m *MockLeaderboardCacheRepository) RemoveInactiveUsers(ctx context.Context, before time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveInactiveUsers", ctx, before)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveInactiveUsers indicates an expected call of RemoveInactiveUsers.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) RemoveInactiveUsers(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveInactiveUsers", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).RemoveInactiveUsers), ctx, before)
+}
+
+// RemoveUser mocks base method.
+func (m *MockLeaderboardCacheRepository) RemoveUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUser indicates an expected call of RemoveUser.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) RemoveUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).RemoveUser), ctx, userID)
+}
+
+// Reset mocks base method.
+func (m *MockLeaderboardCacheRepository) Reset(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) Reset(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).Reset), ctx)
+}
+
+// SetAndRank mocks base method.
+func (m *MockLeaderboardCacheRepository) SetAndRank(ctx context.Context, userID string, score int64) (*domain.ScoreSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAndRank", ctx, userID, score)
+	ret0, _ := ret[0].(*domain.ScoreSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAndRank indicates an expected call of SetAndRank.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) SetAndRank(ctx, userID, score any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAndRank", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).SetAndRank), ctx, userID, score)
+}
+
+// SubmitAndRank mocks base method.
+func (m *MockLeaderboardCacheRepository) SubmitAndRank(ctx context.Context, userID string, score int64, seed *domain.ScoreSeed) (*domain.ScoreSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitAndRank", ctx, userID, score, seed)
+	ret0, _ := ret[0].(*domain.ScoreSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitAndRank indicates an expected call of SubmitAndRank.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) SubmitAndRank(ctx, userID, score, seed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAndRank", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).SubmitAndRank), ctx, userID, score, seed)
+}
+
+// TouchActivity mocks base method.
+func (m *MockLeaderboardCacheRepository) TouchActivity(ctx context.Context, userID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchActivity", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchActivity indicates an expected call of TouchActivity.
+func (mr *MockLeaderboardCacheRepositoryMockRecorder) TouchActivity(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchActivity", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).TouchActivity), ctx, userID, at)
+}
+
 // UpdateScore mocks base method.
 func (m *MockLeaderboardCacheRepository) UpdateScore(ctx context.Context, userID string, score int64) error {
 	m.ctrl.T.Helper()
@@ -178,3 +536,215 @@ func (mr *MockLeaderboardCacheRepositoryMockRecorder) UpdateScore(ctx, userID, s
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScore", reflect.TypeOf((*MockLeaderboardCacheRepository)(nil).UpdateScore), ctx, userID, score)
 }
+
+// MockScoreAuditRepository is a mock of ScoreAuditRepository interface.
+type MockScoreAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScoreAuditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockScoreAuditRepositoryMockRecorder is the mock recorder for MockScoreAuditRepository.
+type MockScoreAuditRepositoryMockRecorder struct {
+	mock *MockScoreAuditRepository
+}
+
+// NewMockScoreAuditRepository creates a new mock instance.
+func NewMockScoreAuditRepository(ctrl *gomock.Controller) *MockScoreAuditRepository {
+	mock := &MockScoreAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockScoreAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScoreAuditRepository) EXPECT() *MockScoreAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockScoreAuditRepository) List(ctx context.Context, userID string, limit, offset int64) ([]domain.ScoreAuditEntry, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]domain.ScoreAuditEntry)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockScoreAuditRepositoryMockRecorder) List(ctx, userID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockScoreAuditRepository)(nil).List), ctx, userID, limit, offset)
+}
+
+// Record mocks base method.
+func (m *MockScoreAuditRepository) Record(ctx context.Context, entry *domain.ScoreAuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockScoreAuditRepositoryMockRecorder) Record(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockScoreAuditRepository)(nil).Record), ctx, entry)
+}
+
+// MockSeasonRepository is a mock of SeasonRepository interface.
+type MockSeasonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeasonRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSeasonRepositoryMockRecorder is the mock recorder for MockSeasonRepository.
+type MockSeasonRepositoryMockRecorder struct {
+	mock *MockSeasonRepository
+}
+
+// NewMockSeasonRepository creates a new mock instance.
+func NewMockSeasonRepository(ctrl *gomock.Controller) *MockSeasonRepository {
+	mock := &MockSeasonRepository{ctrl: ctrl}
+	mock.recorder = &MockSeasonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeasonRepository) EXPECT() *MockSeasonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockSeasonRepository) Archive(ctx context.Context, seasonID string, endedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, seasonID, endedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockSeasonRepositoryMockRecorder) Archive(ctx, seasonID, endedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockSeasonRepository)(nil).Archive), ctx, seasonID, endedAt)
+}
+
+// Create mocks base method.
+func (m *MockSeasonRepository) Create(ctx context.Context, season *domain.Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, season)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeasonRepositoryMockRecorder) Create(ctx, season any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeasonRepository)(nil).Create), ctx, season)
+}
+
+// GetActive mocks base method.
+func (m *MockSeasonRepository) GetActive(ctx context.Context) (*domain.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx)
+	ret0, _ := ret[0].(*domain.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockSeasonRepositoryMockRecorder) GetActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockSeasonRepository)(nil).GetActive), ctx)
+}
+
+// GetBestRank mocks base method.
+func (m *MockSeasonRepository) GetBestRank(ctx context.Context, userID string) (*domain.BestRank, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBestRank", ctx, userID)
+	ret0, _ := ret[0].(*domain.BestRank)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBestRank indicates an expected call of GetBestRank.
+func (mr *MockSeasonRepositoryMockRecorder) GetBestRank(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBestRank", reflect.TypeOf((*MockSeasonRepository)(nil).GetBestRank), ctx, userID)
+}
+
+// GetEndedNearest mocks base method.
+func (m *MockSeasonRepository) GetEndedNearest(ctx context.Context, at, before time.Time) (*domain.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndedNearest", ctx, at, before)
+	ret0, _ := ret[0].(*domain.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEndedNearest indicates an expected call of GetEndedNearest.
+func (mr *MockSeasonRepositoryMockRecorder) GetEndedNearest(ctx, at, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndedNearest", reflect.TypeOf((*MockSeasonRepository)(nil).GetEndedNearest), ctx, at, before)
+}
+
+// GetLatestEnded mocks base method.
+func (m *MockSeasonRepository) GetLatestEnded(ctx context.Context) (*domain.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestEnded", ctx)
+	ret0, _ := ret[0].(*domain.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestEnded indicates an expected call of GetLatestEnded.
+func (mr *MockSeasonRepositoryMockRecorder) GetLatestEnded(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEnded", reflect.TypeOf((*MockSeasonRepository)(nil).GetLatestEnded), ctx)
+}
+
+// GetRankHistory mocks base method.
+func (m *MockSeasonRepository) GetRankHistory(ctx context.Context, userID string, start, end time.Time) ([]domain.RankPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRankHistory", ctx, userID, start, end)
+	ret0, _ := ret[0].([]domain.RankPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankHistory indicates an expected call of GetRankHistory.
+func (mr *MockSeasonRepositoryMockRecorder) GetRankHistory(ctx, userID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankHistory", reflect.TypeOf((*MockSeasonRepository)(nil).GetRankHistory), ctx, userID, start, end)
+}
+
+// GetStandings mocks base method.
+func (m *MockSeasonRepository) GetStandings(ctx context.Context, seasonID string) ([]domain.SeasonStanding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandings", ctx, seasonID)
+	ret0, _ := ret[0].([]domain.SeasonStanding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStandings indicates an expected call of GetStandings.
+func (mr *MockSeasonRepositoryMockRecorder) GetStandings(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandings", reflect.TypeOf((*MockSeasonRepository)(nil).GetStandings), ctx, seasonID)
+}
+
+// List mocks base method.
+func (m *MockSeasonRepository) List(ctx context.Context, limit, offset int64) ([]domain.Season, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]domain.Season)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockSeasonRepositoryMockRecorder) List(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSeasonRepository)(nil).List), ctx, limit, offset)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: real-time-leaderboard/internal/module/leaderboard/application (interfaces: SubmissionQuotaRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../infrastructure/mocks/submission_quota_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application SubmissionQuotaRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSubmissionQuotaRepository is a mock of SubmissionQuotaRepository interface.
+type MockSubmissionQuotaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubmissionQuotaRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSubmissionQuotaRepositoryMockRecorder is the mock recorder for MockSubmissionQuotaRepository.
+type MockSubmissionQuotaRepositoryMockRecorder struct {
+	mock *MockSubmissionQuotaRepository
+}
+
+// NewMockSubmissionQuotaRepository creates a new mock instance.
+func NewMockSubmissionQuotaRepository(ctrl *gomock.Controller) *MockSubmissionQuotaRepository {
+	mock := &MockSubmissionQuotaRepository{ctrl: ctrl}
+	mock.recorder = &MockSubmissionQuotaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubmissionQuotaRepository) EXPECT() *MockSubmissionQuotaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Decrement mocks base method.
+func (m *MockSubmissionQuotaRepository) Decrement(ctx context.Context, userID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrement", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decrement indicates an expected call of Decrement.
+func (mr *MockSubmissionQuotaRepositoryMockRecorder) Decrement(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockSubmissionQuotaRepository)(nil).Decrement), ctx, userID, at)
+}
+
+// Increment mocks base method.
+func (m *MockSubmissionQuotaRepository) Increment(ctx context.Context, userID string, at time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, userID, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockSubmissionQuotaRepositoryMockRecorder) Increment(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockSubmissionQuotaRepository)(nil).Increment), ctx, userID, at)
+}
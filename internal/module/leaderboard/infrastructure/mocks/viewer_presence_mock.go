@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: real-time-leaderboard/internal/module/leaderboard/application (interfaces: ViewerPresenceRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../infrastructure/mocks/viewer_presence_mock.go -package=mocks real-time-leaderboard/internal/module/leaderboard/application ViewerPresenceRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockViewerPresenceRepository is a mock of ViewerPresenceRepository interface.
+type MockViewerPresenceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewerPresenceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockViewerPresenceRepositoryMockRecorder is the mock recorder for MockViewerPresenceRepository.
+type MockViewerPresenceRepositoryMockRecorder struct {
+	mock *MockViewerPresenceRepository
+}
+
+// NewMockViewerPresenceRepository creates a new mock instance.
+func NewMockViewerPresenceRepository(ctrl *gomock.Controller) *MockViewerPresenceRepository {
+	mock := &MockViewerPresenceRepository{ctrl: ctrl}
+	mock.recorder = &MockViewerPresenceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewerPresenceRepository) EXPECT() *MockViewerPresenceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockViewerPresenceRepository) Count(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockViewerPresenceRepositoryMockRecorder) Count(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockViewerPresenceRepository)(nil).Count), ctx)
+}
+
+// Leave mocks base method.
+func (m *MockViewerPresenceRepository) Leave(ctx context.Context, connectionID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leave", ctx, connectionID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Leave indicates an expected call of Leave.
+func (mr *MockViewerPresenceRepositoryMockRecorder) Leave(ctx, connectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leave", reflect.TypeOf((*MockViewerPresenceRepository)(nil).Leave), ctx, connectionID)
+}
+
+// Touch mocks base method.
+func (m *MockViewerPresenceRepository) Touch(ctx context.Context, connectionID string, ttl time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, connectionID, ttl)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockViewerPresenceRepositoryMockRecorder) Touch(ctx, connectionID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockViewerPresenceRepository)(nil).Touch), ctx, connectionID, ttl)
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// CachedUserRepository caches the usernames of another UserRepository in Redis for a short TTL.
+// Usernames cannot be changed once registered, so the TTL only bounds how long a deleted user stays visible.
+// Cache failures fall back to the wrapped repository; email verification is never cached.
+type CachedUserRepository struct {
+	next   application.UserRepository
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewCachedUserRepository wraps next with a Redis username cache whose entries live for ttl
+func NewCachedUserRepository(next application.UserRepository, client *redis.Client, ttl time.Duration) application.UserRepository {
+	return &CachedUserRepository{next: next, client: client, ttl: ttl}
+}
+
+// GetByIDs serves cached usernames and loads only the misses from the wrapped repository, caching them
+func (r *CachedUserRepository) GetByIDs(ctx context.Context, userIDs []string) (map[string]string, error) {
+	if len(userIDs) == 0 {
+		return make(map[string]string), nil
+	}
+
+	keys := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		keys[i] = domain.RedisUsernameKeyPrefix + id
+	}
+
+	result := make(map[string]string, len(userIDs))
+	missing := userIDs
+	// A failed cache read is treated as all misses
+	if values, err := r.client.MGet(ctx, keys...).Result(); err == nil {
+		missing = nil
+		for i, value := range values {
+			if username, ok := value.(string); ok {
+				result[userIDs[i]] = username
+			} else {
+				missing = append(missing, userIDs[i])
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	loaded, err := r.next.GetByIDs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	pipe := r.client.Pipeline()
+	for id, username := range loaded {
+		result[id] = username
+		pipe.Set(ctx, domain.RedisUsernameKeyPrefix+id, username, r.ttl)
+	}
+	// Best-effort: the next read reloads anything that failed to cache
+	_, _ = pipe.Exec(ctx)
+
+	return result, nil
+}
+
+// IsEmailVerified always asks the wrapped repository so a fresh verification takes effect immediately
+func (r *CachedUserRepository) IsEmailVerified(ctx context.Context, userID string) (bool, error) {
+	return r.next.IsEmailVerified(ctx, userID)
+}
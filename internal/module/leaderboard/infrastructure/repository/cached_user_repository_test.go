@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"real-time-leaderboard/internal/module/leaderboard/infrastructure/mocks"
+)
+
+func newTestCachedUserRepository(t *testing.T, next *mocks.MockUserRepository, ttl time.Duration) (*CachedUserRepository, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	return &CachedUserRepository{next: next, client: client, ttl: ttl}, mr
+}
+
+func TestCachedUserRepository_GetByIDs_WhenCalledTwiceWithinTTL_ShouldHitUserRepoOnce(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1", "user-2"}).
+		Return(map[string]string{"user-1": "alice", "user-2": "bob"}, nil).
+		Times(1)
+
+	repo, _ := newTestCachedUserRepository(t, mockUserRepo, time.Minute)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	first, firstErr := repo.GetByIDs(ctx, []string{"user-1", "user-2"})
+	second, secondErr := repo.GetByIDs(ctx, []string{"user-1", "user-2"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.Equal(t, map[string]string{"user-1": "alice", "user-2": "bob"}, first)
+	require.Equal(t, first, second)
+}
+
+func TestCachedUserRepository_GetByIDs_WhenSomeCached_ShouldLoadOnlyMisses(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	gomock.InOrder(
+		mockUserRepo.EXPECT().
+			GetByIDs(ctx, []string{"user-1"}).
+			Return(map[string]string{"user-1": "alice"}, nil),
+		mockUserRepo.EXPECT().
+			GetByIDs(ctx, []string{"user-2"}).
+			Return(map[string]string{"user-2": "bob"}, nil),
+	)
+
+	repo, _ := newTestCachedUserRepository(t, mockUserRepo, time.Minute)
+	_, err := repo.GetByIDs(ctx, []string{"user-1"})
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	usernames, err := repo.GetByIDs(ctx, []string{"user-1", "user-2"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"user-1": "alice", "user-2": "bob"}, usernames)
+}
+
+func TestCachedUserRepository_GetByIDs_WhenTTLExpired_ShouldReloadFromUserRepo(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1"}).
+		Return(map[string]string{"user-1": "alice"}, nil).
+		Times(2)
+
+	repo, mr := newTestCachedUserRepository(t, mockUserRepo, time.Minute)
+	_, err := repo.GetByIDs(ctx, []string{"user-1"})
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	mr.FastForward(time.Minute + time.Second)
+	usernames, err := repo.GetByIDs(ctx, []string{"user-1"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"user-1": "alice"}, usernames)
+}
+
+func TestCachedUserRepository_GetByIDs_WhenRedisUnavailable_ShouldFallBackToUserRepo(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1"}).
+		Return(map[string]string{"user-1": "alice"}, nil).
+		Times(1)
+
+	repo, mr := newTestCachedUserRepository(t, mockUserRepo, time.Minute)
+	mr.Close()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	usernames, err := repo.GetByIDs(ctx, []string{"user-1"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"user-1": "alice"}, usernames)
+}
+
+func TestCachedUserRepository_GetByIDs_WhenUserRepoFails_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo.EXPECT().
+		GetByIDs(ctx, []string{"user-1"}).
+		Return(nil, errors.New("database error")).
+		Times(1)
+
+	repo, _ := newTestCachedUserRepository(t, mockUserRepo, time.Minute)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := repo.GetByIDs(ctx, []string{"user-1"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.EqualError(t, err, "database error")
+}
@@ -3,65 +3,184 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"real-time-leaderboard/internal/module/leaderboard/application"
 	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/database"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresLeaderboardRepository implements LeaderboardPersistenceRepository using PostgreSQL
 // Stores the highest score per user as persistent storage
 type PostgresLeaderboardRepository struct {
-	pool *pgxpool.Pool
+	pool          *pgxpool.Pool
+	order         domain.SortOrder
+	minBoardScore int64
 }
 
-// NewPostgresLeaderboardRepository creates a new PostgreSQL leaderboard persistence repository
-func NewPostgresLeaderboardRepository(pool *pgxpool.Pool) application.LeaderboardPersistenceRepository {
-	return &PostgresLeaderboardRepository{pool: pool}
+// NewPostgresLeaderboardRepository creates a new PostgreSQL leaderboard persistence repository.
+// order decides whether the highest (desc) or lowest (asc) score ranks first.
+// Scores below minBoardScore are stored but left out of the board and its player count (0 disables).
+func NewPostgresLeaderboardRepository(pool *pgxpool.Pool, order domain.SortOrder, minBoardScore int64) application.LeaderboardPersistenceRepository {
+	return &PostgresLeaderboardRepository{pool: pool, order: order, minBoardScore: minBoardScore}
 }
 
-// UpsertScore upserts the score for a user
-// If user doesn't exist, creates a new record with the given score
-// If user exists, updates the score
+// boardFilter returns the WHERE clause keeping scores below the board minimum off the board
+func (r *PostgresLeaderboardRepository) boardFilter() string {
+	if r.minBoardScore <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("WHERE l.score >= %d", r.minBoardScore)
+}
+
+// UpsertScore keeps the user's best score: creates a record with the given score for a new user, and
+// replaces an existing score only when the new one beats it (higher for desc, lower for asc)
 func (r *PostgresLeaderboardRepository) UpsertScore(ctx context.Context, userID string, score int64) error {
+	return r.writeScore(database.WithQueryName(ctx, "UpsertScore"), userID, score, upsertScoreQuery(r.order, false))
+}
+
+// SetScore stores the score for a user, replacing any existing score even when it is worse
+func (r *PostgresLeaderboardRepository) SetScore(ctx context.Context, userID string, score int64) error {
+	return r.writeScore(database.WithQueryName(ctx, "SetScore"), userID, score, upsertScoreQuery(r.order, true))
+}
+
+// upsertScoreQuery returns the insert-or-update statement for a user's score.
+// Unless overwrite is set, the update is guarded so a score that does not beat the stored one in order
+// leaves the row, including updated_at, unchanged; a late or duplicate write can then never lower a best.
+func upsertScoreQuery(order domain.SortOrder, overwrite bool) string {
+	guard := ""
+	if !overwrite {
+		comparison := ">"
+		if order == domain.SortOrderAsc {
+			comparison = "<"
+		}
+		guard = "WHERE EXCLUDED.score " + comparison + " leaderboard.score"
+	}
+
+	return fmt.Sprintf(`
+		INSERT INTO leaderboard (id, user_id, score, created_at, updated_at)
+		VALUES (uuid_generate_v4(), $1, $2, $3, $3)
+		ON CONFLICT (user_id)
+		DO UPDATE SET
+			score = EXCLUDED.score,
+			updated_at = EXCLUDED.updated_at
+		%s
+	`, guard)
+}
+
+// writeScore runs an upsert built by upsertScoreQuery; callers name the query on ctx
+func (r *PostgresLeaderboardRepository) writeScore(ctx context.Context, userID string, score int64, query string) error {
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to upsert score: %w", err)
+	}
+	defer release()
+
+	if _, err := r.pool.Exec(ctx, query, userID, score, time.Now()); err != nil {
+		return fmt.Errorf("failed to upsert score: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteScore removes the user's persisted score
+func (r *PostgresLeaderboardRepository) DeleteScore(ctx context.Context, userID string) error {
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete score: %w", err)
+	}
+	defer release()
+
+	if _, err := r.pool.Exec(database.WithQueryName(ctx, "DeleteScore"), `DELETE FROM leaderboard WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete score: %w", err)
+	}
+
+	return nil
+}
+
+// IncrementScore adds delta to the score for a user in a single statement and returns the new total
+// If user doesn't exist, creates a new record with delta as the score
+func (r *PostgresLeaderboardRepository) IncrementScore(ctx context.Context, userID string, delta int64) (int64, error) {
 	now := time.Now()
 
 	query := `
 		INSERT INTO leaderboard (id, user_id, score, created_at, updated_at)
-		VALUES (uuid_generate_v4(), $1, $2, $3, $4)
-		ON CONFLICT (user_id) 
-		DO UPDATE SET 
-			score = $2,
-			updated_at = $4
+		VALUES (uuid_generate_v4(), $1, $2, $3, $3)
+		ON CONFLICT (user_id)
+		DO UPDATE SET
+			score = leaderboard.score + EXCLUDED.score,
+			updated_at = EXCLUDED.updated_at
+		RETURNING score
 	`
 
-	_, err := r.pool.Exec(ctx, query, userID, score, now, now)
+	release, err := database.AcquireQuery(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to upsert score: %w", err)
+		return 0, fmt.Errorf("failed to increment score: %w", err)
 	}
+	defer release()
 
-	return nil
+	var total int64
+	if err := r.pool.QueryRow(database.WithQueryName(ctx, "IncrementScore"), query, userID, delta, now).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to increment score: %w", err)
+	}
+
+	return total, nil
+}
+
+// GetScore returns the user's persisted score, including one below the board minimum; found is false when they have none
+func (r *PostgresLeaderboardRepository) GetScore(ctx context.Context, userID string) (int64, bool, error) {
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get score: %w", err)
+	}
+	defer release()
+
+	var score int64
+	err = r.pool.QueryRow(database.WithQueryName(ctx, "GetScore"), `SELECT score FROM leaderboard WHERE user_id = $1`, userID).Scan(&score)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get score: %w", err)
+	}
+
+	return score, true, nil
 }
 
 // GetLeaderboard retrieves a paginated leaderboard from PostgreSQL with usernames and total count
 func (r *PostgresLeaderboardRepository) GetLeaderboard(ctx context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error) {
-	query := `
+	direction := "DESC"
+	if r.order == domain.SortOrderAsc {
+		direction = "ASC"
+	}
+
+	query := fmt.Sprintf(`
 		SELECT 
 			l.user_id,
 			u.username,
 			l.score,
-			ROW_NUMBER() OVER (ORDER BY l.score DESC) as rank,
+			ROW_NUMBER() OVER (ORDER BY l.score %[1]s) as rank,
 			COUNT(*) OVER() as total
 		FROM leaderboard l
 		JOIN users u ON l.user_id = u.id
-		ORDER BY l.score DESC
+		%[2]s
+		ORDER BY l.score %[1]s
 		LIMIT $1 OFFSET $2
-	`
+	`, direction, r.boardFilter())
 
-	rows, err := r.pool.Query(ctx, query, limit, offset)
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get leaderboard: %w", err)
+	}
+	defer release()
+
+	rows, err := r.pool.Query(database.WithQueryName(ctx, "GetLeaderboard"), query, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get leaderboard: %w", err)
 	}
@@ -83,3 +202,98 @@ func (r *PostgresLeaderboardRepository) GetLeaderboard(ctx context.Context, limi
 
 	return entries, total, nil
 }
+
+// GetTotalPlayers returns the number of users with a persisted score on the board
+func (r *PostgresLeaderboardRepository) GetTotalPlayers(ctx context.Context) (int64, error) {
+	query := `SELECT COUNT(*) FROM leaderboard l ` + r.boardFilter()
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count players: %w", err)
+	}
+	defer release()
+
+	var total int64
+	if err := r.pool.QueryRow(database.WithQueryName(ctx, "GetTotalPlayers"), query).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to count players: %w", err)
+	}
+
+	return total, nil
+}
+
+// GetUserEntry computes the user's rank as one plus the number of board scores strictly better than theirs.
+// Tied users share a rank here, while the cache breaks ties by user ID, so the two can differ on ties.
+func (r *PostgresLeaderboardRepository) GetUserEntry(ctx context.Context, userID string) (*domain.LeaderboardEntry, error) {
+	better := ">"
+	if r.order == domain.SortOrderAsc {
+		better = "<"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			l.score,
+			(SELECT COUNT(*) FROM leaderboard o WHERE o.score %[1]s l.score AND o.score >= $2) + 1 AS rank
+		FROM leaderboard l
+		WHERE l.user_id = $1 AND l.score >= $2
+	`, better)
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user rank: %w", err)
+	}
+	defer release()
+
+	// Without a board minimum every score is on the board
+	minScore := r.minBoardScore
+	if minScore <= 0 {
+		minScore = math.MinInt64
+	}
+
+	entry := domain.LeaderboardEntry{UserID: userID}
+	err = r.pool.QueryRow(database.WithQueryName(ctx, "GetUserEntry"), query, userID, minScore).Scan(&entry.Score, &entry.Rank)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user rank: %w", err)
+	}
+
+	return &entry, nil
+}
+
+// GetUpdatedTimes returns the updated_at of each listed user's score in a single query
+func (r *PostgresLeaderboardRepository) GetUpdatedTimes(ctx context.Context, userIDs []string) (map[string]time.Time, error) {
+	if len(userIDs) == 0 {
+		return make(map[string]time.Time), nil
+	}
+
+	query := `SELECT user_id, updated_at FROM leaderboard WHERE user_id = ANY($1)`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get score update times: %w", err)
+	}
+	defer release()
+
+	rows, err := r.pool.Query(database.WithQueryName(ctx, "GetUpdatedTimes"), query, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get score update times: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]time.Time)
+	for rows.Next() {
+		var userID string
+		var updatedAt time.Time
+		if err := rows.Scan(&userID, &updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan score update time: %w", err)
+		}
+		result[userID] = updatedAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating score update times: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+)
+
+func TestUpsertScoreQuery_WhenOrderDescending_ShouldOnlyReplaceLowerStoredScore(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	query := upsertScoreQuery(domain.SortOrderDesc, false)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	// A lower score than the stored one fails the guard, leaving the stored higher score in place
+	require.Contains(t, query, "WHERE EXCLUDED.score > leaderboard.score")
+}
+
+func TestUpsertScoreQuery_WhenOrderAscending_ShouldOnlyReplaceHigherStoredScore(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	query := upsertScoreQuery(domain.SortOrderAsc, false)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Contains(t, query, "WHERE EXCLUDED.score < leaderboard.score")
+}
+
+func TestUpsertScoreQuery_WhenOverwrite_ShouldReplaceAnyStoredScore(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	query := upsertScoreQuery(domain.SortOrderDesc, true)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Contains(t, query, "score = EXCLUDED.score")
+	require.NotContains(t, query, "WHERE")
+}
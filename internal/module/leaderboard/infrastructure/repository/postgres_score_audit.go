@@ -0,0 +1,88 @@
+// Package repository provides repository implementations for the leaderboard module.
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresScoreAuditRepository implements ScoreAuditRepository using PostgreSQL
+type PostgresScoreAuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresScoreAuditRepository creates a new PostgreSQL score audit repository
+func NewPostgresScoreAuditRepository(pool *pgxpool.Pool) application.ScoreAuditRepository {
+	return &PostgresScoreAuditRepository{pool: pool}
+}
+
+// Record inserts a score submission or increment audit entry
+func (r *PostgresScoreAuditRepository) Record(ctx context.Context, entry *domain.ScoreAuditEntry) error {
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+
+	query := `
+		INSERT INTO score_audit (id, user_id, score, delta, accepted, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record score audit entry: %w", err)
+	}
+	defer release()
+
+	_, err = r.pool.Exec(database.WithQueryName(ctx, "RecordScoreAudit"), query, entry.ID, entry.UserID, entry.Score, entry.Delta, entry.Accepted, entry.Reason, entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record score audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves audit entries newest first with total count; an empty userID lists all users
+func (r *PostgresScoreAuditRepository) List(ctx context.Context, userID string, limit, offset int64) ([]domain.ScoreAuditEntry, int64, error) {
+	query := `
+		SELECT id, user_id, score, delta, accepted, reason, created_at, COUNT(*) OVER() as total
+		FROM score_audit
+		WHERE $1 = '' OR user_id::text = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list score audit entries: %w", err)
+	}
+	defer release()
+
+	rows, err := r.pool.Query(database.WithQueryName(ctx, "ListScoreAudit"), query, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list score audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []domain.ScoreAuditEntry{}
+	var total int64
+	for rows.Next() {
+		var entry domain.ScoreAuditEntry
+		if err := rows.Scan(&entry.ID, &entry.UserID, &entry.Score, &entry.Delta, &entry.Accepted, &entry.Reason, &entry.CreatedAt, &total); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan score audit entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating score audit entries: %w", err)
+	}
+
+	return entries, total, nil
+}
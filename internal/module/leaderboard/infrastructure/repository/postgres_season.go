@@ -0,0 +1,335 @@
+// Package repository provides repository implementations for the leaderboard module.
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const pgUniqueViolation = "23505"
+
+// PostgresSeasonRepository implements SeasonRepository using PostgreSQL
+type PostgresSeasonRepository struct {
+	pool  *pgxpool.Pool
+	order domain.SortOrder
+}
+
+// NewPostgresSeasonRepository creates a new PostgreSQL season repository.
+// order decides how archived standings are ranked, as for the live board.
+func NewPostgresSeasonRepository(pool *pgxpool.Pool, order domain.SortOrder) application.SeasonRepository {
+	return &PostgresSeasonRepository{pool: pool, order: order}
+}
+
+// GetActive retrieves the season that has not ended yet, or nil if there is none
+func (r *PostgresSeasonRepository) GetActive(ctx context.Context) (*domain.Season, error) {
+	query := `
+		SELECT id, name, started_at, ended_at
+		FROM seasons
+		WHERE ended_at IS NULL
+	`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active season: %w", err)
+	}
+	defer release()
+
+	var season domain.Season
+	err = r.pool.QueryRow(database.WithQueryName(ctx, "GetActiveSeason"), query).Scan(&season.ID, &season.Name, &season.StartedAt, &season.EndedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get active season: %w", err)
+	}
+
+	return &season, nil
+}
+
+// Create inserts a new active season. The partial unique index on open seasons turns a concurrent
+// start into ErrSeasonAlreadyActive.
+func (r *PostgresSeasonRepository) Create(ctx context.Context, season *domain.Season) error {
+	if season.ID == "" {
+		season.ID = uuid.New().String()
+	}
+
+	query := `
+		INSERT INTO seasons (id, name, started_at)
+		VALUES ($1, $2, $3)
+	`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create season: %w", err)
+	}
+	defer release()
+
+	_, err = r.pool.Exec(database.WithQueryName(ctx, "CreateSeason"), query, season.ID, season.Name, season.StartedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			return domain.ErrSeasonAlreadyActive
+		}
+		return fmt.Errorf("failed to create season: %w", err)
+	}
+
+	return nil
+}
+
+// Archive ends the season, copies the persisted board into its standings and empties the board,
+// all in one transaction so a failure leaves both the season and the board untouched
+func (r *PostgresSeasonRepository) Archive(ctx context.Context, seasonID string, endedAt time.Time) error {
+	direction := "DESC"
+	if r.order == domain.SortOrderAsc {
+		direction = "ASC"
+	}
+
+	endQuery := `UPDATE seasons SET ended_at = $2 WHERE id = $1 AND ended_at IS NULL`
+	snapshotQuery := fmt.Sprintf(`
+		INSERT INTO season_standings (season_id, user_id, score, rank)
+		SELECT $1, user_id, score, ROW_NUMBER() OVER (ORDER BY score %s)
+		FROM leaderboard
+	`, direction)
+	clearQuery := `DELETE FROM leaderboard`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to archive season: %w", err)
+	}
+	defer release()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to archive season: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Ending first makes a concurrent archive of the same season wait on the row lock, then find it ended
+	tag, err := tx.Exec(database.WithQueryName(ctx, "EndSeason"), endQuery, seasonID, endedAt)
+	if err != nil {
+		return fmt.Errorf("failed to end season: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNoActiveSeason
+	}
+
+	if _, err := tx.Exec(database.WithQueryName(ctx, "SnapshotSeasonStandings"), snapshotQuery, seasonID); err != nil {
+		return fmt.Errorf("failed to snapshot season standings: %w", err)
+	}
+
+	if _, err := tx.Exec(database.WithQueryName(ctx, "ClearLeaderboard"), clearQuery); err != nil {
+		return fmt.Errorf("failed to clear leaderboard: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to archive season: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves seasons newest first with total count
+func (r *PostgresSeasonRepository) List(ctx context.Context, limit, offset int64) ([]domain.Season, int64, error) {
+	query := `
+		SELECT id, name, started_at, ended_at, COUNT(*) OVER() as total
+		FROM seasons
+		ORDER BY started_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list seasons: %w", err)
+	}
+	defer release()
+
+	rows, err := r.pool.Query(database.WithQueryName(ctx, "ListSeasons"), query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list seasons: %w", err)
+	}
+	defer rows.Close()
+
+	seasons := []domain.Season{}
+	var total int64
+	for rows.Next() {
+		var season domain.Season
+		if err := rows.Scan(&season.ID, &season.Name, &season.StartedAt, &season.EndedAt, &total); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan season: %w", err)
+		}
+		seasons = append(seasons, season)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating seasons: %w", err)
+	}
+
+	return seasons, total, nil
+}
+
+// GetBestRank retrieves the user's lowest archived rank, preferring the earliest season on ties
+func (r *PostgresSeasonRepository) GetBestRank(ctx context.Context, userID string) (*domain.BestRank, error) {
+	query := `
+		SELECT ss.user_id, ss.rank, ss.score, s.id, s.name, s.ended_at
+		FROM season_standings ss
+		JOIN seasons s ON s.id = ss.season_id
+		WHERE ss.user_id = $1
+		ORDER BY ss.rank ASC, s.ended_at ASC
+		LIMIT 1
+	`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get best rank: %w", err)
+	}
+	defer release()
+
+	var best domain.BestRank
+	err = r.pool.QueryRow(database.WithQueryName(ctx, "GetBestRank"), query, userID).Scan(&best.UserID, &best.Rank, &best.Score, &best.SeasonID, &best.SeasonName, &best.At)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get best rank: %w", err)
+	}
+
+	return &best, nil
+}
+
+// GetRankHistory retrieves the user's final rank in every season that ended within [start, end], oldest first.
+// Seasons the user did not place in are kept as unranked points so gaps show in the history.
+func (r *PostgresSeasonRepository) GetRankHistory(ctx context.Context, userID string, start, end time.Time) ([]domain.RankPoint, error) {
+	query := `
+		SELECT s.id, s.name, s.ended_at, ss.rank, ss.score
+		FROM seasons s
+		LEFT JOIN season_standings ss ON ss.season_id = s.id AND ss.user_id = $1
+		WHERE s.ended_at IS NOT NULL AND s.ended_at >= $2 AND s.ended_at <= $3
+		ORDER BY s.ended_at ASC
+	`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rank history: %w", err)
+	}
+	defer release()
+
+	rows, err := r.pool.Query(database.WithQueryName(ctx, "GetRankHistory"), query, userID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rank history: %w", err)
+	}
+	defer rows.Close()
+
+	points := []domain.RankPoint{}
+	for rows.Next() {
+		var point domain.RankPoint
+		var rank, score *int64
+		if err := rows.Scan(&point.SeasonID, &point.SeasonName, &point.At, &rank, &score); err != nil {
+			return nil, fmt.Errorf("failed to scan rank point: %w", err)
+		}
+		if rank != nil && score != nil {
+			point.Rank, point.Score, point.Ranked = *rank, *score, true
+		}
+		points = append(points, point)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rank history: %w", err)
+	}
+
+	return points, nil
+}
+
+// GetLatestEnded retrieves the most recently ended season, or nil if none has ended
+func (r *PostgresSeasonRepository) GetLatestEnded(ctx context.Context) (*domain.Season, error) {
+	query := `
+		SELECT id, name, started_at, ended_at
+		FROM seasons
+		WHERE ended_at IS NOT NULL
+		ORDER BY ended_at DESC
+		LIMIT 1
+	`
+
+	return r.getOne(database.WithQueryName(ctx, "GetLatestEndedSeason"), "failed to get latest ended season", query)
+}
+
+// GetEndedNearest retrieves the season that ended before before with its end closest to at, preferring the later
+// season on ties, or nil if there is none
+func (r *PostgresSeasonRepository) GetEndedNearest(ctx context.Context, at, before time.Time) (*domain.Season, error) {
+	query := `
+		SELECT id, name, started_at, ended_at
+		FROM seasons
+		WHERE ended_at IS NOT NULL AND ended_at < $2
+		ORDER BY ABS(EXTRACT(EPOCH FROM (ended_at - $1::timestamptz))) ASC, ended_at DESC
+		LIMIT 1
+	`
+
+	return r.getOne(database.WithQueryName(ctx, "GetEndedNearestSeason"), "failed to get nearest ended season", query, at, before)
+}
+
+// getOne runs a query selecting at most one season, returning nil when it selects none.
+// Callers name the query on ctx with database.WithQueryName.
+func (r *PostgresSeasonRepository) getOne(ctx context.Context, errMsg, query string, args ...any) (*domain.Season, error) {
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errMsg, err)
+	}
+	defer release()
+
+	var season domain.Season
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&season.ID, &season.Name, &season.StartedAt, &season.EndedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	return &season, nil
+}
+
+// GetStandings retrieves the archived standings of a season, best rank first
+func (r *PostgresSeasonRepository) GetStandings(ctx context.Context, seasonID string) ([]domain.SeasonStanding, error) {
+	query := `
+		SELECT user_id, rank, score
+		FROM season_standings
+		WHERE season_id = $1
+		ORDER BY rank ASC
+	`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season standings: %w", err)
+	}
+	defer release()
+
+	rows, err := r.pool.Query(database.WithQueryName(ctx, "GetSeasonStandings"), query, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season standings: %w", err)
+	}
+	defer rows.Close()
+
+	standings := []domain.SeasonStanding{}
+	for rows.Next() {
+		var standing domain.SeasonStanding
+		if err := rows.Scan(&standing.UserID, &standing.Rank, &standing.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan season standing: %w", err)
+		}
+		standings = append(standings, standing)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating season standings: %w", err)
+	}
+
+	return standings, nil
+}
@@ -4,6 +4,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"real-time-leaderboard/internal/module/leaderboard/application"
 	"real-time-leaderboard/internal/module/leaderboard/domain"
@@ -11,22 +13,316 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// bumpVersionLua defines bumpVersion(versionKey, updatedAtKey), which increments the board version and
+// records when it changed, in unix milliseconds on the Redis clock so every instance agrees
+const bumpVersionLua = `
+local function bumpVersion(versionKey, updatedAtKey)
+	redis.call('INCR', versionKey)
+	local now = redis.call('TIME')
+	redis.call('SET', updatedAtKey, tonumber(now[1]) * 1000 + math.floor(tonumber(now[2]) / 1000))
+end
+`
+
+// removeInactiveScript removes up to ARGV[2] of the users whose recorded activity (KEYS[2]) is older than ARGV[1]
+// from the leaderboard (KEYS[1]), bumping the board version (KEYS[3], KEYS[4]) when anyone was removed.
+// Running it as a script keeps the check and removal atomic, so a submission landing during a sweep is never evicted.
+// The batch is the lowest-scored range of the activity set, so ZREMRANGEBYRANK drops exactly the users read.
+var removeInactiveScript = redis.NewScript(bumpVersionLua + `
+local removed = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', '(' .. ARGV[1], 'LIMIT', 0, tonumber(ARGV[2]))
+if #removed == 0 then
+	return removed
+end
+redis.call('ZREM', KEYS[1], unpack(removed))
+redis.call('ZREMRANGEBYRANK', KEYS[2], 0, #removed - 1)
+bumpVersion(KEYS[3], KEYS[4])
+return removed
+`)
+
+// inactiveEvictionBatchSize caps how many users one removeInactiveScript run evicts, so a large backlog of
+// inactive players is removed in several short scripts instead of one that blocks Redis
+const inactiveEvictionBatchSize = 500
+
+// expireBoardLua defines expireBoard(ttl), which sets every key passed to the script to expire after ttl
+// milliseconds; a ttl of 0 leaves them persistent
+const expireBoardLua = `
+local function expireBoard(ttl)
+	if ttl > 0 then
+		for _, key in ipairs(KEYS) do
+			redis.call('PEXPIRE', key, ttl)
+		end
+	end
+end
+`
+
+// restoreSeedLua defines restoreSeed(member, seed, minBoard), which puts a member the cache no longer holds back
+// with its persisted score seed, on the board (KEYS[1]) or, below minBoard, in KEYS[3], bumping the board version
+// (KEYS[2], KEYS[5]) when it goes on the board. It returns the restored score and whether it is on the board; a
+// seed of "none" restores nothing and returns false. The seed string itself is stored so no precision is lost.
+const restoreSeedLua = `
+local function restoreSeed(member, seed, minBoard)
+	local score = tonumber(seed)
+	if not score then
+		return false, false
+	end
+	if minBoard and score < minBoard then
+		redis.call('ZADD', KEYS[3], seed, member)
+		return score, false
+	end
+	redis.call('ZADD', KEYS[1], seed, member)
+	bumpVersion(KEYS[2], KEYS[5])
+	return score, true
+end
+`
+
+// Script results report these in place of a rank (or of an increment's applied flag) when they refuse the write
+const (
+	// scriptNotCached means the member is in neither the board nor KEYS[3] and no seed was passed
+	scriptNotCached = -1
+	// scriptNotLoaded means the board's loaded marker (KEYS[6]) is missing, e.g. after the board expired
+	scriptNotLoaded = -2
+)
+
+// submitAndRankScript sets member ARGV[2] to score ARGV[1] only when it beats the member's current best (higher
+// for "desc", lower for "asc" in ARGV[3]), bumping the board version (KEYS[2]) when the leaderboard (KEYS[1])
+// changes, then returns {1-based rank, 1 if the score changed, 1 if the member took rank 1 from someone else or
+// an empty board}.
+// When ARGV[4] is a number, a best below it is kept in KEYS[3] instead of on the board, so it still counts as
+// the member's best, and the returned rank is 0.
+// Running it as a script removes the race between the leader lookup, the update and the rank fetch.
+// ARGV[5] refreshes the board keys' TTL as in expireBoardLua, and KEYS[5] records when the version changed.
+// ARGV[6] set to "set" overwrites the score even when it does not beat the member's best.
+// Otherwise a member held in neither KEYS[1] nor KEYS[3] is first restored from the seed in ARGV[7] as in
+// restoreSeedLua, or, when ARGV[7] is empty, left alone and reported with rank scriptNotCached.
+// Nothing is written while the loaded marker (KEYS[6]) is missing, since ranks and leaders would be computed
+// against a partial board; the script reports rank scriptNotLoaded so the caller can load the board first.
+var submitAndRankScript = redis.NewScript(expireBoardLua + bumpVersionLua + restoreSeedLua + `
+local function run()
+	if redis.call('EXISTS', KEYS[6]) == 0 then
+		return {-2, 0, 0}
+	end
+	local asc = ARGV[3] == 'asc'
+	local score = tonumber(ARGV[1])
+	local minBoard = tonumber(ARGV[4])
+	local current = redis.call('ZSCORE', KEYS[1], ARGV[2])
+	local onBoard = current ~= false
+	if not onBoard then
+		current = redis.call('ZSCORE', KEYS[3], ARGV[2])
+	end
+	if not current and ARGV[6] ~= 'set' then
+		if ARGV[7] == '' then
+			return {-1, 0, 0}
+		end
+		current, onBoard = restoreSeed(ARGV[2], ARGV[7], minBoard)
+	end
+	local leader
+	if asc then
+		leader = redis.call('ZRANGE', KEYS[1], 0, 0)
+	else
+		leader = redis.call('ZREVRANGE', KEYS[1], 0, 0)
+	end
+	local changed = ARGV[6] == 'set' or not current or (asc and score < tonumber(current)) or (not asc and score > tonumber(current))
+	if changed and minBoard and score < minBoard then
+		redis.call('ZADD', KEYS[3], ARGV[1], ARGV[2])
+		if onBoard then
+			redis.call('ZREM', KEYS[1], ARGV[2])
+			bumpVersion(KEYS[2], KEYS[5])
+		end
+		return {0, 1, 0}
+	end
+	if changed then
+		redis.call('ZREM', KEYS[3], ARGV[2])
+		redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+		bumpVersion(KEYS[2], KEYS[5])
+	elseif not onBoard then
+		return {0, 0, 0}
+	end
+	local rank
+	if asc then
+		rank = redis.call('ZRANK', KEYS[1], ARGV[2])
+	else
+		rank = redis.call('ZREVRANK', KEYS[1], ARGV[2])
+	end
+	local newLeader = 0
+	if changed and rank == 0 and leader[1] ~= ARGV[2] then
+		newLeader = 1
+	end
+	return {rank + 1, changed and 1 or 0, newLeader}
+end
+local result = run()
+expireBoard(tonumber(ARGV[5]))
+return result
+`)
+
+// incrementAndRankScript adds ARGV[1] to member ARGV[2]'s score (starting from 0) only when the new total stays
+// within [ARGV[3], ARGV[4]], bumping the board version (KEYS[2]) when the leaderboard (KEYS[1]) changes, then
+// returns {1 if applied, new total, 1-based rank,
+// 1 if the member took rank 1 from someone else or an empty board}. ARGV[5] is the sort order as in
+// submitAndRankScript. A rejected increment returns the total it would have reached and rank 0.
+// ARGV[6] and KEYS[3] keep totals below the board minimum off the board, and ARGV[7] refreshes the board keys'
+// TTL, and KEYS[5] records when the version changed, as in submitAndRankScript. A member missing from the cache
+// is restored from the seed in ARGV[8], or reported with scriptNotCached, and nothing is written while the
+// board is not loaded, as in submitAndRankScript.
+var incrementAndRankScript = redis.NewScript(expireBoardLua + bumpVersionLua + restoreSeedLua + `
+local function run()
+	if redis.call('EXISTS', KEYS[6]) == 0 then
+		return {-2, 0, 0, 0}
+	end
+	local asc = ARGV[5] == 'asc'
+	local minBoard = tonumber(ARGV[6])
+	local current = redis.call('ZSCORE', KEYS[1], ARGV[2])
+	local onBoard = current ~= false
+	if not onBoard then
+		current = redis.call('ZSCORE', KEYS[3], ARGV[2])
+	end
+	if not current then
+		if ARGV[8] == '' then
+			return {-1, 0, 0, 0}
+		end
+		current, onBoard = restoreSeed(ARGV[2], ARGV[8], minBoard)
+	end
+	local total = (tonumber(current) or 0) + tonumber(ARGV[1])
+	if total < tonumber(ARGV[3]) or total > tonumber(ARGV[4]) then
+		return {0, total, 0, 0}
+	end
+	if minBoard and total < minBoard then
+		redis.call('ZADD', KEYS[3], total, ARGV[2])
+		if onBoard then
+			redis.call('ZREM', KEYS[1], ARGV[2])
+			bumpVersion(KEYS[2], KEYS[5])
+		end
+		return {1, total, 0, 0}
+	end
+	local leader
+	if asc then
+		leader = redis.call('ZRANGE', KEYS[1], 0, 0)
+	else
+		leader = redis.call('ZREVRANGE', KEYS[1], 0, 0)
+	end
+	redis.call('ZREM', KEYS[3], ARGV[2])
+	redis.call('ZADD', KEYS[1], total, ARGV[2])
+	bumpVersion(KEYS[2], KEYS[5])
+	local rank
+	if asc then
+		rank = redis.call('ZRANK', KEYS[1], ARGV[2])
+	else
+		rank = redis.call('ZREVRANK', KEYS[1], ARGV[2])
+	end
+	local newLeader = 0
+	if rank == 0 and leader[1] ~= ARGV[2] then
+		newLeader = 1
+	end
+	return {1, total, rank + 1, newLeader}
+end
+local result = run()
+expireBoard(tonumber(ARGV[7]))
+return result
+`)
+
+// resetScript deletes the board (KEYS[1]), the scores below the board minimum (KEYS[2]) and the activity
+// records (KEYS[3]) along with the loaded marker (KEYS[6]), then bumps the board version (KEYS[4], KEYS[5])
+var resetScript = redis.NewScript(bumpVersionLua + `
+redis.call('DEL', KEYS[1], KEYS[2], KEYS[3], KEYS[6])
+bumpVersion(KEYS[4], KEYS[5])
+`)
+
+// removeUserScript drops member ARGV[1] from the board (KEYS[1]), the scores below the board minimum (KEYS[3])
+// and the activity records (KEYS[4]), bumping the board version (KEYS[2], KEYS[5]) when they were on the board
+var removeUserScript = redis.NewScript(bumpVersionLua + `
+local removed = redis.call('ZREM', KEYS[1], ARGV[1])
+redis.call('ZREM', KEYS[3], ARGV[1])
+redis.call('ZREM', KEYS[4], ARGV[1])
+if removed == 1 then
+	bumpVersion(KEYS[2], KEYS[5])
+end
+return removed
+`)
+
 // RedisLeaderboardRepository implements LeaderboardCacheRepository using Redis sorted sets
 type RedisLeaderboardRepository struct {
-	client *redis.Client
+	client        *redis.Client
+	order         domain.SortOrder
+	minBoardScore int64
+	boardTTL      time.Duration
+}
+
+// boardKeys are the keys that make up the board; with a board TTL every write refreshes them together
+// so they expire together
+var boardKeys = []string{
+	domain.RedisLeaderboardKey,
+	domain.RedisLeaderboardVersionKey,
+	domain.RedisBelowBoardMinimumKey,
+	domain.RedisLastActivityKey,
+	domain.RedisLeaderboardUpdatedAtKey,
+	domain.RedisLeaderboardLoadedKey,
+}
+
+// NewRedisLeaderboardRepository creates a new Redis leaderboard cache repository.
+// order decides whether the highest (desc) or lowest (asc) score ranks first.
+// Submissions and increments leaving a user's best below minBoardScore keep it off the board (0 disables).
+// Every write pushes the board's expiry boardTTL into the future, so only a board left without writes for that
+// long expires; reads then reload it from persistence (0 never expires).
+func NewRedisLeaderboardRepository(client *redis.Client, order domain.SortOrder, minBoardScore int64, boardTTL time.Duration) application.LeaderboardCacheRepository {
+	return &RedisLeaderboardRepository{client: client, order: order, minBoardScore: minBoardScore, boardTTL: boardTTL}
+}
+
+// expireBoard queues a refresh of the board keys' TTL on pipe; it does nothing without a board TTL
+func (r *RedisLeaderboardRepository) expireBoard(ctx context.Context, pipe redis.Pipeliner) {
+	if r.boardTTL <= 0 {
+		return
+	}
+	for _, key := range boardKeys {
+		pipe.PExpire(ctx, key, r.boardTTL)
+	}
 }
 
-// NewRedisLeaderboardRepository creates a new Redis leaderboard cache repository
-func NewRedisLeaderboardRepository(client *redis.Client) application.LeaderboardCacheRepository {
-	return &RedisLeaderboardRepository{client: client}
+// minBoardArg returns the board minimum as a script argument; an empty string disables it
+func (r *RedisLeaderboardRepository) minBoardArg() any {
+	if r.minBoardScore <= 0 {
+		return ""
+	}
+	return r.minBoardScore
+}
+
+// seedArg returns seed as a script argument for restoreSeedLua; nil gives an empty string, which makes the
+// script report a member missing from the cache instead of writing
+func seedArg(seed *domain.ScoreSeed) any {
+	if seed == nil {
+		return ""
+	}
+	if !seed.Found {
+		return "none"
+	}
+	return seed.Score
 }
 
-// UpdateScore updates the score in the leaderboard (does not publish notifications)
+// rangeWithScores reads ranks start..stop (0-based, inclusive) in the board's sort order
+func (r *RedisLeaderboardRepository) rangeWithScores(ctx context.Context, start, stop int64) *redis.ZSliceCmd {
+	if r.order == domain.SortOrderAsc {
+		return r.client.ZRangeWithScores(ctx, domain.RedisLeaderboardKey, start, stop)
+	}
+	return r.client.ZRevRangeWithScores(ctx, domain.RedisLeaderboardKey, start, stop)
+}
+
+// rank queues a 0-based rank lookup in the board's sort order on c (the client or a pipeline)
+func (r *RedisLeaderboardRepository) rank(ctx context.Context, c redis.Cmdable, userID string) *redis.IntCmd {
+	if r.order == domain.SortOrderAsc {
+		return c.ZRank(ctx, domain.RedisLeaderboardKey, userID)
+	}
+	return c.ZRevRank(ctx, domain.RedisLeaderboardKey, userID)
+}
+
+// UpdateScore updates the score in the leaderboard (does not publish notifications).
+// Sorted set scores are float64, so only scores up to domain.MaxSafeScore (2^53) are stored exactly;
+// the score use case rejects anything larger before it reaches the cache.
 func (r *RedisLeaderboardRepository) UpdateScore(ctx context.Context, userID string, score int64) error {
-	err := r.client.ZAdd(ctx, domain.RedisLeaderboardKey, redis.Z{
-		Score:  float64(score),
-		Member: userID,
-	}).Err()
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, domain.RedisLeaderboardKey, redis.Z{
+			Score:  float64(score),
+			Member: userID,
+		})
+		r.expireBoard(ctx, pipe)
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("failed to update score in leaderboard: %w", err)
 	}
@@ -34,6 +330,93 @@ func (r *RedisLeaderboardRepository) UpdateScore(ctx context.Context, userID str
 	return nil
 }
 
+// SubmitAndRank keeps the user's best score and returns the resulting rank in a single atomic round-trip.
+// A user missing from the cache is restored from seed first, or reported with domain.ErrScoreNotCached when seed is nil.
+// Returns domain.ErrLeaderboardNotLoaded, writing nothing, while the board is not loaded from persistence.
+func (r *RedisLeaderboardRepository) SubmitAndRank(ctx context.Context, userID string, score int64, seed *domain.ScoreSeed) (*domain.ScoreSubmission, error) {
+	direction := string(domain.SortOrderDesc)
+	if r.order == domain.SortOrderAsc {
+		direction = string(domain.SortOrderAsc)
+	}
+
+	result, err := submitAndRankScript.Run(ctx, r.client, boardKeys, score, userID, direction, r.minBoardArg(), r.boardTTL.Milliseconds(), "", seedArg(seed)).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit score to leaderboard: %w", err)
+	}
+	if len(result) != 3 {
+		return nil, fmt.Errorf("failed to submit score to leaderboard: unexpected script result %v", result)
+	}
+	switch result[0] {
+	case scriptNotCached:
+		return nil, domain.ErrScoreNotCached
+	case scriptNotLoaded:
+		return nil, domain.ErrLeaderboardNotLoaded
+	}
+
+	return &domain.ScoreSubmission{
+		Rank:        result[0],
+		Improved:    result[1] == 1,
+		IsNewLeader: result[2] == 1,
+	}, nil
+}
+
+// SetAndRank overwrites the user's score, even with a worse one, and returns the resulting rank in a single
+// atomic round-trip. The board minimum and the loaded check apply as in SubmitAndRank.
+func (r *RedisLeaderboardRepository) SetAndRank(ctx context.Context, userID string, score int64) (*domain.ScoreSubmission, error) {
+	direction := string(domain.SortOrderDesc)
+	if r.order == domain.SortOrderAsc {
+		direction = string(domain.SortOrderAsc)
+	}
+
+	result, err := submitAndRankScript.Run(ctx, r.client, boardKeys, score, userID, direction, r.minBoardArg(), r.boardTTL.Milliseconds(), "set", "none").Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to set score in leaderboard: %w", err)
+	}
+	if len(result) != 3 {
+		return nil, fmt.Errorf("failed to set score in leaderboard: unexpected script result %v", result)
+	}
+	if result[0] == scriptNotLoaded {
+		return nil, domain.ErrLeaderboardNotLoaded
+	}
+
+	return &domain.ScoreSubmission{
+		Rank:        result[0],
+		Improved:    result[1] == 1,
+		IsNewLeader: result[2] == 1,
+	}, nil
+}
+
+// IncrementAndRank adds delta to the user's score and returns the resulting total and rank in a single atomic round-trip.
+// Totals outside [minScore, maxScore] leave the board unchanged and are reported with Applied false.
+// seed and the loaded check apply as in SubmitAndRank.
+func (r *RedisLeaderboardRepository) IncrementAndRank(ctx context.Context, userID string, delta, minScore, maxScore int64, seed *domain.ScoreSeed) (*domain.ScoreIncrement, error) {
+	direction := string(domain.SortOrderDesc)
+	if r.order == domain.SortOrderAsc {
+		direction = string(domain.SortOrderAsc)
+	}
+
+	result, err := incrementAndRankScript.Run(ctx, r.client, boardKeys, delta, userID, minScore, maxScore, direction, r.minBoardArg(), r.boardTTL.Milliseconds(), seedArg(seed)).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to increment score in leaderboard: %w", err)
+	}
+	if len(result) != 4 {
+		return nil, fmt.Errorf("failed to increment score in leaderboard: unexpected script result %v", result)
+	}
+	switch result[0] {
+	case scriptNotCached:
+		return nil, domain.ErrScoreNotCached
+	case scriptNotLoaded:
+		return nil, domain.ErrLeaderboardNotLoaded
+	}
+
+	return &domain.ScoreIncrement{
+		Applied:     result[0] == 1,
+		Score:       result[1],
+		Rank:        result[2],
+		IsNewLeader: result[3] == 1,
+	}, nil
+}
+
 // GetLeaderboard retrieves a paginated leaderboard with total count
 func (r *RedisLeaderboardRepository) GetLeaderboard(ctx context.Context, limit, offset int64) ([]domain.LeaderboardEntry, int64, error) {
 	start := offset
@@ -46,7 +429,7 @@ func (r *RedisLeaderboardRepository) GetLeaderboard(ctx context.Context, limit,
 	}
 
 	// Get paginated entries
-	results, err := r.client.ZRevRangeWithScores(ctx, domain.RedisLeaderboardKey, start, stop).Result()
+	results, err := r.rangeWithScores(ctx, start, stop).Result()
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get top players: %w", err)
 	}
@@ -70,17 +453,241 @@ func (r *RedisLeaderboardRepository) GetLeaderboard(ctx context.Context, limit,
 	return entries, total, nil
 }
 
-// GetUserRank retrieves the rank of a user in the leaderboard (1-indexed)
+// GetUserRank retrieves the rank of a user in the leaderboard (1-indexed).
+// Returns domain.ErrUserNotInLeaderboard when the user has no score in the leaderboard.
 func (r *RedisLeaderboardRepository) GetUserRank(ctx context.Context, userID string) (int64, error) {
-	rank, err := r.client.ZRevRank(ctx, domain.RedisLeaderboardKey, userID).Result()
+	rank, err := r.rank(ctx, r.client, userID).Result()
 	if err != nil {
 		if err == redis.Nil {
-			// User not found in leaderboard
-			return 0, fmt.Errorf("user not found in leaderboard")
+			return 0, domain.ErrUserNotInLeaderboard
 		}
 		return 0, fmt.Errorf("failed to get user rank: %w", err)
 	}
 
-	// ZRevRank returns 0-based rank, convert to 1-based
+	// Sorted set ranks are 0-based, convert to 1-based
 	return rank + 1, nil
 }
+
+// GetTotalPlayers returns the number of users in the cached leaderboard
+func (r *RedisLeaderboardRepository) GetTotalPlayers(ctx context.Context) (int64, error) {
+	total, err := r.client.ZCard(ctx, domain.RedisLeaderboardKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count players: %w", err)
+	}
+	return total, nil
+}
+
+// GetUserEntry retrieves the rank (1-indexed) and score of a user in a single round-trip.
+// Returns nil without error when the user is not in the leaderboard.
+func (r *RedisLeaderboardRepository) GetUserEntry(ctx context.Context, userID string) (*domain.LeaderboardEntry, error) {
+	pipe := r.client.Pipeline()
+	rankCmd := r.rank(ctx, pipe, userID)
+	scoreCmd := pipe.ZScore(ctx, domain.RedisLeaderboardKey, userID)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to get user entry: %w", err)
+	}
+
+	rank, err := rankCmd.Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user rank: %w", err)
+	}
+
+	score, err := scoreCmd.Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user score: %w", err)
+	}
+
+	return &domain.LeaderboardEntry{
+		UserID: userID,
+		Score:  int64(score),
+		Rank:   rank + 1,
+	}, nil
+}
+
+// GetUserEntries retrieves the rank (1-indexed) and score of several users in a single round-trip.
+// Users not in the leaderboard are absent from the returned map.
+func (r *RedisLeaderboardRepository) GetUserEntries(ctx context.Context, userIDs []string) (map[string]domain.LeaderboardEntry, error) {
+	entries := make(map[string]domain.LeaderboardEntry, len(userIDs))
+	if len(userIDs) == 0 {
+		return entries, nil
+	}
+
+	pipe := r.client.Pipeline()
+	rankCmds := make([]*redis.IntCmd, len(userIDs))
+	scoreCmds := make([]*redis.FloatCmd, len(userIDs))
+	for i, userID := range userIDs {
+		rankCmds[i] = r.rank(ctx, pipe, userID)
+		scoreCmds[i] = pipe.ZScore(ctx, domain.RedisLeaderboardKey, userID)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to get user entries: %w", err)
+	}
+
+	for i, userID := range userIDs {
+		rank, err := rankCmds[i].Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user rank: %w", err)
+		}
+
+		score, err := scoreCmds[i].Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user score: %w", err)
+		}
+
+		entries[userID] = domain.LeaderboardEntry{
+			UserID: userID,
+			Score:  int64(score),
+			Rank:   rank + 1,
+		}
+	}
+
+	return entries, nil
+}
+
+// GetScoreBounds returns the lowest and highest score in a single round-trip, regardless of sort order
+func (r *RedisLeaderboardRepository) GetScoreBounds(ctx context.Context) (int64, int64, bool, error) {
+	pipe := r.client.Pipeline()
+	lowestCmd := pipe.ZRangeWithScores(ctx, domain.RedisLeaderboardKey, 0, 0)
+	highestCmd := pipe.ZRevRangeWithScores(ctx, domain.RedisLeaderboardKey, 0, 0)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, 0, false, fmt.Errorf("failed to get score bounds: %w", err)
+	}
+
+	lowest, highest := lowestCmd.Val(), highestCmd.Val()
+	if len(lowest) == 0 || len(highest) == 0 {
+		return 0, 0, false, nil
+	}
+
+	return int64(lowest[0].Score), int64(highest[0].Score), true, nil
+}
+
+// CountScores counts the players within each bucket's inclusive score range in a single round-trip
+func (r *RedisLeaderboardRepository) CountScores(ctx context.Context, buckets []domain.ScoreBucket) ([]int64, error) {
+	if len(buckets) == 0 {
+		return []int64{}, nil
+	}
+
+	pipe := r.client.Pipeline()
+	countCmds := make([]*redis.IntCmd, len(buckets))
+	for i, bucket := range buckets {
+		countCmds[i] = pipe.ZCount(ctx, domain.RedisLeaderboardKey, strconv.FormatInt(bucket.Min, 10), strconv.FormatInt(bucket.Max, 10))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to count scores: %w", err)
+	}
+
+	counts := make([]int64, len(buckets))
+	for i, cmd := range countCmds {
+		counts[i] = cmd.Val()
+	}
+
+	return counts, nil
+}
+
+// GetVersion returns the board version, which changes whenever a score changes (0 before the first change)
+func (r *RedisLeaderboardRepository) GetVersion(ctx context.Context) (int64, error) {
+	version, err := r.client.Get(ctx, domain.RedisLeaderboardVersionKey).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get leaderboard version: %w", err)
+	}
+
+	return version, nil
+}
+
+// GetUpdatedAt returns when the board version last changed (zero before the first change)
+func (r *RedisLeaderboardRepository) GetUpdatedAt(ctx context.Context) (time.Time, error) {
+	ms, err := r.client.Get(ctx, domain.RedisLeaderboardUpdatedAtKey).Int64()
+	if err == redis.Nil {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get leaderboard update time: %w", err)
+	}
+
+	return time.UnixMilli(ms).UTC(), nil
+}
+
+// IsLoaded reports whether the board's loaded marker is present
+func (r *RedisLeaderboardRepository) IsLoaded(ctx context.Context) (bool, error) {
+	n, err := r.client.Exists(ctx, domain.RedisLeaderboardLoadedKey).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check leaderboard loaded marker: %w", err)
+	}
+
+	return n == 1, nil
+}
+
+// MarkLoaded sets the board's loaded marker with the board TTL, so it expires no later than the board keys
+func (r *RedisLeaderboardRepository) MarkLoaded(ctx context.Context) error {
+	// A zero expiration keeps the marker for as long as the board, which never expires without a TTL
+	ttl := max(r.boardTTL, 0)
+	if err := r.client.Set(ctx, domain.RedisLeaderboardLoadedKey, 1, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set leaderboard loaded marker: %w", err)
+	}
+
+	return nil
+}
+
+// TouchActivity records the time of a user's latest score submission
+func (r *RedisLeaderboardRepository) TouchActivity(ctx context.Context, userID string, at time.Time) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, domain.RedisLastActivityKey, redis.Z{Score: float64(at.Unix()), Member: userID})
+		r.expireBoard(ctx, pipe)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record user activity: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveInactiveUsers removes users whose last recorded activity is before the given time, in batches of
+// inactiveEvictionBatchSize. Users without recorded activity are never considered inactive.
+// On error, the IDs removed by the batches that already ran are returned with it.
+func (r *RedisLeaderboardRepository) RemoveInactiveUsers(ctx context.Context, before time.Time) ([]string, error) {
+	keys := []string{domain.RedisLeaderboardKey, domain.RedisLastActivityKey, domain.RedisLeaderboardVersionKey, domain.RedisLeaderboardUpdatedAtKey}
+	var removed []string
+	for {
+		batch, err := removeInactiveScript.Run(ctx, r.client, keys, before.Unix(), inactiveEvictionBatchSize).StringSlice()
+		if err != nil {
+			return removed, fmt.Errorf("failed to remove inactive users: %w", err)
+		}
+		removed = append(removed, batch...)
+		if len(batch) < inactiveEvictionBatchSize {
+			return removed, nil
+		}
+	}
+}
+
+// Reset deletes the board, the scores kept below the board minimum and the activity records in one script and bumps the board version
+func (r *RedisLeaderboardRepository) Reset(ctx context.Context) error {
+	keys := []string{domain.RedisLeaderboardKey, domain.RedisBelowBoardMinimumKey, domain.RedisLastActivityKey, domain.RedisLeaderboardVersionKey, domain.RedisLeaderboardUpdatedAtKey, domain.RedisLeaderboardLoadedKey}
+	err := resetScript.Run(ctx, r.client, keys).Err()
+	if err == redis.Nil {
+		err = nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to reset leaderboard: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveUser drops the user's score, the best kept below the board minimum and their activity record in one script
+func (r *RedisLeaderboardRepository) RemoveUser(ctx context.Context, userID string) error {
+	if err := removeUserScript.Run(ctx, r.client, boardKeys, userID).Err(); err != nil {
+		return fmt.Errorf("failed to remove user from leaderboard: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,749 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+)
+
+// noStoredScore seeds writes as for users without a persisted score, so they start from none like a new player
+var noStoredScore = &domain.ScoreSeed{}
+
+func newTestRedisRepository(t *testing.T) (*RedisLeaderboardRepository, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	repo := &RedisLeaderboardRepository{client: client}
+	// Writes are refused until the board is loaded from persistence
+	require.NoError(t, repo.MarkLoaded(context.Background()))
+	return repo, mr
+}
+
+func TestRedisLeaderboardRepository_GetUserRank_WhenUserRanked_ShouldReturnOneBasedRank(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 1000))
+	require.NoError(t, repo.UpdateScore(ctx, "user-2", 500))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	rank, err := repo.GetUserRank(ctx, "user-2")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rank)
+}
+
+func TestRedisLeaderboardRepository_GetUserRank_WhenUserAbsent_ShouldReturnErrUserNotInLeaderboard(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 1000))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := repo.GetUserRank(ctx, "user-404")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, domain.ErrUserNotInLeaderboard)
+}
+
+func TestRedisLeaderboardRepository_GetUserRank_WhenRedisUnavailable_ShouldReturnWrappedError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	mr.Close()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := repo.GetUserRank(ctx, "user-1")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.NotErrorIs(t, err, domain.ErrUserNotInLeaderboard)
+	require.Contains(t, err.Error(), "failed to get user rank")
+}
+
+func TestRedisLeaderboardRepository_RemoveInactiveUsers_WhenUserInactiveBeyondWindow_ShouldEvictOnlyInactive(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	now := time.Now()
+	require.NoError(t, repo.UpdateScore(ctx, "inactive", 1000))
+	require.NoError(t, repo.UpdateScore(ctx, "active", 500))
+	require.NoError(t, repo.UpdateScore(ctx, "untracked", 250))
+	require.NoError(t, repo.TouchActivity(ctx, "inactive", now.Add(-48*time.Hour)))
+	require.NoError(t, repo.TouchActivity(ctx, "active", now.Add(-time.Hour)))
+	require.NoError(t, repo.MarkLoaded(ctx))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	removed, err := repo.RemoveInactiveUsers(ctx, now.Add(-24*time.Hour))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, []string{"inactive"}, removed)
+	// Eviction keeps the board loaded, so the next read does not reload the evicted player from PostgreSQL
+	loaded, err := repo.IsLoaded(ctx)
+	require.NoError(t, err)
+	require.True(t, loaded)
+	entries, total, err := repo.GetLeaderboard(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), total)
+	require.Equal(t, "active", entries[0].UserID)
+	require.Equal(t, "untracked", entries[1].UserID)
+}
+
+func TestRedisLeaderboardRepository_RemoveInactiveUsers_WhenMoreThanOneBatchInactive_ShouldEvictAllInBatches(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	now := time.Now()
+	inactive := inactiveEvictionBatchSize + 5
+	for i := range inactive {
+		userID := fmt.Sprintf("inactive-%d", i)
+		require.NoError(t, repo.UpdateScore(ctx, userID, int64(i+1)))
+		require.NoError(t, repo.TouchActivity(ctx, userID, now.Add(-48*time.Hour)))
+	}
+	require.NoError(t, repo.UpdateScore(ctx, "active", 10))
+	require.NoError(t, repo.TouchActivity(ctx, "active", now))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	removed, err := repo.RemoveInactiveUsers(ctx, now.Add(-24*time.Hour))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, removed, inactive)
+	total, err := repo.GetTotalPlayers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), total)
+	tracked, err := mr.ZMembers(domain.RedisLastActivityKey)
+	require.NoError(t, err)
+	require.Equal(t, []string{"active"}, tracked)
+}
+
+func TestRedisLeaderboardRepository_WhenEvictedUserWritesUnseeded_ShouldReturnErrScoreNotCached(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 1000))
+	evictUser(t, repo, "user-1")
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, submitErr := repo.SubmitAndRank(ctx, "user-1", 500, nil)
+	_, incrementErr := repo.IncrementAndRank(ctx, "user-1", 50, 0, domain.MaxSafeScore, nil)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, submitErr, domain.ErrScoreNotCached)
+	require.ErrorIs(t, incrementErr, domain.ErrScoreNotCached)
+	require.False(t, mr.Exists(domain.RedisLeaderboardKey))
+}
+
+// evictUser removes the user from the board the way the inactivity sweeper does, keeping the board loaded
+func evictUser(t *testing.T, repo *RedisLeaderboardRepository, userID string) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, repo.TouchActivity(ctx, userID, now.Add(-48*time.Hour)))
+	removed, err := repo.RemoveInactiveUsers(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []string{userID}, removed)
+}
+
+func TestRedisLeaderboardRepository_IncrementAndRank_WhenEvictedUserSeeded_ShouldAddToPersistedScore(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "leader", 2000))
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 1000))
+	evictUser(t, repo, "user-1")
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	increment, err := repo.IncrementAndRank(ctx, "user-1", 50, 0, domain.MaxSafeScore, &domain.ScoreSeed{Score: 1000, Found: true})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreIncrement{Score: 1050, Rank: 2, Applied: true}, increment)
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenEvictedUserSeededWithBetterBest_ShouldKeepPersistedBest(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "leader", 2000))
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 1000))
+	evictUser(t, repo, "user-1")
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	submission, err := repo.SubmitAndRank(ctx, "user-1", 500, &domain.ScoreSeed{Score: 1000, Found: true})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 2, Improved: false, IsNewLeader: false}, submission)
+	entry, err := repo.GetUserEntry(ctx, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), entry.Score)
+}
+
+func TestRedisLeaderboardRepository_GetUserEntries_WhenSomeUsersAbsent_ShouldReturnOnlyRankedUsers(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 1000))
+	require.NoError(t, repo.UpdateScore(ctx, "user-2", 500))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, err := repo.GetUserEntries(ctx, []string{"user-2", "user-404", "user-1"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, domain.LeaderboardEntry{UserID: "user-1", Score: 1000, Rank: 1}, entries["user-1"])
+	require.Equal(t, domain.LeaderboardEntry{UserID: "user-2", Score: 500, Rank: 2}, entries["user-2"])
+	require.NotContains(t, entries, "user-404")
+}
+
+func TestRedisLeaderboardRepository_WhenOrderAscending_ShouldRankLowestScoreFirst(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	repo.order = domain.SortOrderAsc
+	require.NoError(t, repo.UpdateScore(ctx, "slow", 95))
+	require.NoError(t, repo.UpdateScore(ctx, "fastest", 42))
+	require.NoError(t, repo.UpdateScore(ctx, "middle", 60))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, total, err := repo.GetLeaderboard(ctx, 10, 0)
+	require.NoError(t, err)
+	rank, rankErr := repo.GetUserRank(ctx, "fastest")
+	entry, entryErr := repo.GetUserEntry(ctx, "slow")
+	batch, batchErr := repo.GetUserEntries(ctx, []string{"middle"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, int64(3), total)
+	require.Equal(t, []domain.LeaderboardEntry{
+		{UserID: "fastest", Score: 42, Rank: 1},
+		{UserID: "middle", Score: 60, Rank: 2},
+		{UserID: "slow", Score: 95, Rank: 3},
+	}, entries)
+
+	require.NoError(t, rankErr)
+	require.Equal(t, int64(1), rank)
+
+	require.NoError(t, entryErr)
+	require.Equal(t, int64(3), entry.Rank)
+
+	require.NoError(t, batchErr)
+	require.Equal(t, int64(2), batch["middle"].Rank)
+}
+
+func TestRedisLeaderboardRepository_WhenOrderUnset_ShouldRankHighestScoreFirst(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "low", 42))
+	require.NoError(t, repo.UpdateScore(ctx, "high", 95))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	entries, _, err := repo.GetLeaderboard(ctx, 10, 0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "high", entries[0].UserID)
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenScoreHigher_ShouldUpdateAndReportNewLeader(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "leader", 1000))
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 500))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	submission, err := repo.SubmitAndRank(ctx, "user-1", 1500, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 1, Improved: true, IsNewLeader: true}, submission)
+
+	score, err := mr.ZScore(domain.RedisLeaderboardKey, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, float64(1500), score)
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenScoreLower_ShouldKeepBestScore(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "leader", 1000))
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 500))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	submission, err := repo.SubmitAndRank(ctx, "user-1", 100, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 2, Improved: false, IsNewLeader: false}, submission)
+
+	score, err := mr.ZScore(domain.RedisLeaderboardKey, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, float64(500), score)
+}
+
+func TestRedisLeaderboardRepository_SetAndRank_WhenScoreLower_ShouldOverwriteAndBumpVersion(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 1500))
+	require.NoError(t, repo.UpdateScore(ctx, "user-2", 1000))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	submission, err := repo.SetAndRank(ctx, "user-1", 100)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 2, Improved: true}, submission)
+
+	score, err := mr.ZScore(domain.RedisLeaderboardKey, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, float64(100), score)
+
+	version, err := mr.Get(domain.RedisLeaderboardVersionKey)
+	require.NoError(t, err)
+	require.Equal(t, "1", version)
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenLeaderImprovesOwnScore_ShouldNotReportNewLeader(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "leader", 1000))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	submission, err := repo.SubmitAndRank(ctx, "leader", 2000, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 1, Improved: true, IsNewLeader: false}, submission)
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenOrderAscending_ShouldKeepLowestScore(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	repo.order = domain.SortOrderAsc
+	require.NoError(t, repo.UpdateScore(ctx, "fastest", 42))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	slower, slowerErr := repo.SubmitAndRank(ctx, "fastest", 50, noStoredScore)
+	faster, fasterErr := repo.SubmitAndRank(ctx, "newcomer", 30, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, slowerErr)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 1, Improved: false, IsNewLeader: false}, slower)
+
+	require.NoError(t, fasterErr)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 1, Improved: true, IsNewLeader: true}, faster)
+}
+
+func TestRedisLeaderboardRepository_IncrementAndRank_WhenRepeated_ShouldAccumulateTotal(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "leader", 120))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	var totals []int64
+	for _, delta := range []int64{100, 50, -20} {
+		increment, err := repo.IncrementAndRank(ctx, "user-1", delta, 0, domain.MaxSafeScore, noStoredScore)
+		require.NoError(t, err)
+		require.True(t, increment.Applied)
+		totals = append(totals, increment.Score)
+	}
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, []int64{100, 150, 130}, totals)
+
+	score, err := mr.ZScore(domain.RedisLeaderboardKey, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, float64(130), score)
+
+	rank, err := repo.GetUserRank(ctx, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rank)
+}
+
+func TestRedisLeaderboardRepository_IncrementAndRank_WhenPassingLeader_ShouldReportNewLeader(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "leader", 1000))
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 900))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	behind, behindErr := repo.IncrementAndRank(ctx, "user-1", 50, 0, domain.MaxSafeScore, noStoredScore)
+	ahead, aheadErr := repo.IncrementAndRank(ctx, "user-1", 100, 0, domain.MaxSafeScore, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, behindErr)
+	require.Equal(t, &domain.ScoreIncrement{Score: 950, Rank: 2, Applied: true, IsNewLeader: false}, behind)
+
+	require.NoError(t, aheadErr)
+	require.Equal(t, &domain.ScoreIncrement{Score: 1050, Rank: 1, Applied: true, IsNewLeader: true}, ahead)
+}
+
+func TestRedisLeaderboardRepository_IncrementAndRank_WhenTotalBelowMinimum_ShouldLeaveScoreUnchanged(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 30))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	increment, err := repo.IncrementAndRank(ctx, "user-1", -50, 0, domain.MaxSafeScore, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreIncrement{Score: -20, Applied: false}, increment)
+
+	score, err := mr.ZScore(domain.RedisLeaderboardKey, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, float64(30), score)
+}
+
+func TestRedisLeaderboardRepository_ScoreHistogram_WhenScoresSpread_ShouldReturnBoundsAndBucketCounts(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	for userID, score := range map[string]int64{"a": 10, "b": 20, "c": 55, "d": 60, "e": 100} {
+		require.NoError(t, repo.UpdateScore(ctx, userID, score))
+	}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	lowest, highest, ok, boundsErr := repo.GetScoreBounds(ctx)
+	counts, countErr := repo.CountScores(ctx, []domain.ScoreBucket{
+		{Min: 10, Max: 40},
+		{Min: 41, Max: 71},
+		{Min: 72, Max: 100},
+	})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, boundsErr)
+	require.True(t, ok)
+	require.Equal(t, int64(10), lowest)
+	require.Equal(t, int64(100), highest)
+
+	require.NoError(t, countErr)
+	require.Equal(t, []int64{2, 2, 1}, counts)
+}
+
+func TestRedisLeaderboardRepository_GetScoreBounds_WhenBoardEmpty_ShouldReportNotOK(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, _, ok, err := repo.GetScoreBounds(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestRedisLeaderboardRepository_GetVersion_WhenScoresChange_ShouldAdvanceOnlyOnChange(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+
+	initial, err := repo.GetVersion(ctx)
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err = repo.SubmitAndRank(ctx, "user-1", 500, noStoredScore)
+	require.NoError(t, err)
+	afterSubmit, err := repo.GetVersion(ctx)
+	require.NoError(t, err)
+
+	_, err = repo.SubmitAndRank(ctx, "user-1", 100, noStoredScore)
+	require.NoError(t, err)
+	afterLowerSubmit, err := repo.GetVersion(ctx)
+	require.NoError(t, err)
+
+	_, err = repo.IncrementAndRank(ctx, "user-1", 10, 0, domain.MaxSafeScore, noStoredScore)
+	require.NoError(t, err)
+	afterIncrement, err := repo.GetVersion(ctx)
+	require.NoError(t, err)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, int64(0), initial)
+	require.Equal(t, int64(1), afterSubmit)
+	require.Equal(t, afterSubmit, afterLowerSubmit)
+	require.Equal(t, int64(2), afterIncrement)
+}
+
+func TestRedisLeaderboardRepository_GetUpdatedAt_WhenScoresChange_ShouldReturnRedisTimeOfLastChange(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+
+	initial, err := repo.GetUpdatedAt(ctx)
+	require.NoError(t, err)
+
+	submittedAt := time.Date(2026, 10, 16, 12, 0, 0, 123*int(time.Millisecond), time.UTC)
+	mr.SetTime(submittedAt)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err = repo.SubmitAndRank(ctx, "user-1", 500, noStoredScore)
+	require.NoError(t, err)
+	afterSubmit, err := repo.GetUpdatedAt(ctx)
+	require.NoError(t, err)
+
+	mr.SetTime(submittedAt.Add(time.Minute))
+	_, err = repo.SubmitAndRank(ctx, "user-1", 100, noStoredScore)
+	require.NoError(t, err)
+	afterLowerSubmit, err := repo.GetUpdatedAt(ctx)
+	require.NoError(t, err)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.True(t, initial.IsZero())
+	require.True(t, submittedAt.Equal(afterSubmit), "got %v", afterSubmit)
+	require.True(t, submittedAt.Equal(afterLowerSubmit), "a score that changes nothing must not move the update time")
+}
+
+func TestRedisLeaderboardRepository_Reset_WhenBoardHasScores_ShouldEmptyBoardAndAdvanceVersion(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	_, err := repo.SubmitAndRank(ctx, "user-1", 1000, noStoredScore)
+	require.NoError(t, err)
+	require.NoError(t, repo.TouchActivity(ctx, "user-1", time.Now()))
+	require.NoError(t, repo.MarkLoaded(ctx))
+	before, err := repo.GetVersion(ctx)
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = repo.Reset(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	total, err := repo.GetTotalPlayers(ctx)
+	require.NoError(t, err)
+	require.Zero(t, total)
+	require.False(t, mr.Exists(domain.RedisLastActivityKey))
+	require.False(t, mr.Exists(domain.RedisLeaderboardLoadedKey))
+	after, err := repo.GetVersion(ctx)
+	require.NoError(t, err)
+	require.Greater(t, after, before)
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenBelowBoardMinimum_ShouldKeepScoreOffBoard(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	repo.minBoardScore = 100
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	submission, err := repo.SubmitAndRank(ctx, "user-1", 50, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 0, Improved: true, IsNewLeader: false}, submission)
+	total, err := repo.GetTotalPlayers(ctx)
+	require.NoError(t, err)
+	require.Zero(t, total)
+
+	score, err := mr.ZScore(domain.RedisBelowBoardMinimumKey, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, float64(50), score)
+
+	// A lower score later still does not beat the best kept off the board
+	submission, err = repo.SubmitAndRank(ctx, "user-1", 20, noStoredScore)
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 0, Improved: false, IsNewLeader: false}, submission)
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenReachingBoardMinimum_ShouldMoveScoreOntoBoard(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	repo.minBoardScore = 100
+	_, err := repo.SubmitAndRank(ctx, "user-1", 50, noStoredScore)
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	submission, err := repo.SubmitAndRank(ctx, "user-1", 150, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreSubmission{Rank: 1, Improved: true, IsNewLeader: true}, submission)
+	score, err := mr.ZScore(domain.RedisLeaderboardKey, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, float64(150), score)
+	require.False(t, mr.Exists(domain.RedisBelowBoardMinimumKey))
+}
+
+func TestRedisLeaderboardRepository_IncrementAndRank_WhenTotalDropsBelowBoardMinimum_ShouldRemoveFromBoard(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	repo.minBoardScore = 100
+	_, err := repo.SubmitAndRank(ctx, "user-1", 120, noStoredScore)
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	increment, err := repo.IncrementAndRank(ctx, "user-1", -30, -domain.MaxSafeScore, domain.MaxSafeScore, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, &domain.ScoreIncrement{Applied: true, Score: 90, Rank: 0, IsNewLeader: false}, increment)
+	_, err = repo.GetUserRank(ctx, "user-1")
+	require.ErrorIs(t, err, domain.ErrUserNotInLeaderboard)
+
+	score, err := mr.ZScore(domain.RedisBelowBoardMinimumKey, "user-1")
+	require.NoError(t, err)
+	require.Equal(t, float64(90), score)
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenBoardTTLSet_ShouldSetAndRefreshTTL(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	repo.boardTTL = time.Hour
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := repo.SubmitAndRank(ctx, "user-1", 100, noStoredScore)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, mr.TTL(domain.RedisLeaderboardKey))
+	require.Equal(t, time.Hour, mr.TTL(domain.RedisLeaderboardVersionKey))
+
+	mr.FastForward(40 * time.Minute)
+	// A score that does not beat the best still counts as activity on the board
+	_, err = repo.SubmitAndRank(ctx, "user-1", 50, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, mr.TTL(domain.RedisLeaderboardKey))
+
+	mr.FastForward(40 * time.Minute)
+	require.True(t, mr.Exists(domain.RedisLeaderboardKey), "a board written within the TTL must not expire")
+}
+
+func TestRedisLeaderboardRepository_UpdateScore_WhenBoardTTLSet_ShouldRefreshTTL(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	repo.boardTTL = time.Hour
+	_, err := repo.IncrementAndRank(ctx, "user-1", 10, 0, domain.MaxSafeScore, noStoredScore)
+	require.NoError(t, err)
+	mr.FastForward(30 * time.Minute)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = repo.UpdateScore(ctx, "user-2", 200)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, mr.TTL(domain.RedisLeaderboardKey))
+
+	mr.FastForward(2 * time.Hour)
+	require.False(t, mr.Exists(domain.RedisLeaderboardKey), "a board left without writes expires")
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenBoardExpired_ShouldRefuseWriteUntilLoaded(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+	repo.boardTTL = time.Hour
+	require.NoError(t, repo.UpdateScore(ctx, "user-1", 100))
+	require.NoError(t, repo.MarkLoaded(ctx))
+	mr.FastForward(2 * time.Hour)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, submitErr := repo.SubmitAndRank(ctx, "user-2", 50, noStoredScore)
+	_, incrementErr := repo.IncrementAndRank(ctx, "user-2", 50, 0, domain.MaxSafeScore, noStoredScore)
+	_, setErr := repo.SetAndRank(ctx, "user-2", 50)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	// A write on the expired board would rank the user against an empty board and take rank 1
+	require.ErrorIs(t, submitErr, domain.ErrLeaderboardNotLoaded)
+	require.ErrorIs(t, incrementErr, domain.ErrLeaderboardNotLoaded)
+	require.ErrorIs(t, setErr, domain.ErrLeaderboardNotLoaded)
+	total, err := repo.GetTotalPlayers(ctx)
+	require.NoError(t, err)
+	require.Zero(t, total)
+
+	require.NoError(t, repo.MarkLoaded(ctx))
+	submission, err := repo.SubmitAndRank(ctx, "user-2", 50, noStoredScore)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), submission.Rank)
+}
+
+func TestRedisLeaderboardRepository_SubmitAndRank_WhenBoardTTLUnset_ShouldNotExpire(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestRedisRepository(t)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := repo.SubmitAndRank(ctx, "user-1", 100, noStoredScore)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Zero(t, mr.TTL(domain.RedisLeaderboardKey))
+}
+
+func TestRedisLeaderboardRepository_RemoveUser_WhenRanked_ShouldLeaveUserNotFoundAndAdvanceVersion(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	_, err := repo.SubmitAndRank(ctx, "user-1", 500, noStoredScore)
+	require.NoError(t, err)
+	_, err = repo.SubmitAndRank(ctx, "user-2", 300, noStoredScore)
+	require.NoError(t, err)
+	require.NoError(t, repo.TouchActivity(ctx, "user-1", time.Now()))
+	before, err := repo.GetVersion(ctx)
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = repo.RemoveUser(ctx, "user-1")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	_, err = repo.GetUserRank(ctx, "user-1")
+	require.ErrorIs(t, err, domain.ErrUserNotInLeaderboard)
+
+	rank, err := repo.GetUserRank(ctx, "user-2")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rank)
+
+	after, err := repo.GetVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, before+1, after)
+
+	removedAgain := repo.RemoveUser(ctx, "user-1")
+	require.NoError(t, removedAgain)
+	unchanged, err := repo.GetVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, after, unchanged, "removing a user who is not ranked changes nothing")
+}
+
+func TestRedisLeaderboardRepository_RemoveUser_WhenBestBelowBoardMinimum_ShouldForgetKeptBest(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, _ := newTestRedisRepository(t)
+	repo.minBoardScore = 100
+	_, err := repo.SubmitAndRank(ctx, "user-1", 50, noStoredScore)
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = repo.RemoveUser(ctx, "user-1")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	submission, err := repo.SubmitAndRank(ctx, "user-1", 40, noStoredScore)
+	require.NoError(t, err)
+	require.True(t, submission.Improved, "a lower score counts as a new best once the old best is forgotten")
+}
@@ -0,0 +1,33 @@
+// Package repository provides repository implementations for the leaderboard module.
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisSubmissionNonceRepository implements SubmissionNonceRepository with one expiring Redis key per nonce
+type RedisSubmissionNonceRepository struct {
+	client *redis.Client
+}
+
+// NewRedisSubmissionNonceRepository creates a new Redis submission nonce repository
+func NewRedisSubmissionNonceRepository(client *redis.Client) application.SubmissionNonceRepository {
+	return &RedisSubmissionNonceRepository{client: client}
+}
+
+// Reserve records nonce with SET NX so that, across instances, only its first use succeeds
+func (r *RedisSubmissionNonceRepository) Reserve(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	ok, err := r.client.SetNX(ctx, domain.RedisSubmissionNonceKeyPrefix+nonce, 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve submission nonce: %w", err)
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+)
+
+func TestRedisSubmissionNonceRepository_Reserve_WhenNonceReused_ShouldRejectUntilItExpires(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	repo := NewRedisSubmissionNonceRepository(client)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	first, err := repo.Reserve(ctx, "nonce-1", 10*time.Minute)
+	require.NoError(t, err)
+	replay, err := repo.Reserve(ctx, "nonce-1", 10*time.Minute)
+	require.NoError(t, err)
+	mr.FastForward(10 * time.Minute)
+	afterExpiry, err := repo.Reserve(ctx, "nonce-1", 10*time.Minute)
+	require.NoError(t, err)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.True(t, first)
+	require.False(t, replay)
+	require.True(t, afterExpiry)
+	require.Equal(t, 10*time.Minute, mr.TTL(domain.RedisSubmissionNonceKeyPrefix+"nonce-1"))
+}
@@ -0,0 +1,63 @@
+// Package repository provides repository implementations for the leaderboard module.
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisSubmissionQuotaRepository implements SubmissionQuotaRepository with one Redis counter per user and UTC day
+type RedisSubmissionQuotaRepository struct {
+	client *redis.Client
+}
+
+// NewRedisSubmissionQuotaRepository creates a new Redis submission quota repository
+func NewRedisSubmissionQuotaRepository(client *redis.Client) application.SubmissionQuotaRepository {
+	return &RedisSubmissionQuotaRepository{client: client}
+}
+
+// Increment bumps the user's counter for the UTC day containing at, expiring it at the following midnight
+func (r *RedisSubmissionQuotaRepository) Increment(ctx context.Context, userID string, at time.Time) (int64, error) {
+	day, key := quotaKey(userID, at)
+
+	var incr *redis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireAt(ctx, key, day.Add(24*time.Hour))
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count score submission: %w", err)
+	}
+
+	return incr.Val(), nil
+}
+
+// Decrement lowers the user's counter for the UTC day containing at. The expiry is set again, so a counter
+// that already expired because the day is over is removed straight away instead of left at -1.
+func (r *RedisSubmissionQuotaRepository) Decrement(ctx context.Context, userID string, at time.Time) error {
+	day, key := quotaKey(userID, at)
+
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Decr(ctx, key)
+		pipe.ExpireAt(ctx, key, day.Add(24*time.Hour))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release score submission: %w", err)
+	}
+
+	return nil
+}
+
+// quotaKey returns the start of the UTC day containing at and the user's counter key for that day
+func quotaKey(userID string, at time.Time) (time.Time, string) {
+	day := at.UTC().Truncate(24 * time.Hour)
+	return day, domain.RedisSubmissionQuotaKeyPrefix + day.Format(time.DateOnly) + ":" + userID
+}
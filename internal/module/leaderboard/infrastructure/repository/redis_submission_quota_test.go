@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+)
+
+func newTestSubmissionQuotaRepository(t *testing.T) (*RedisSubmissionQuotaRepository, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	return &RedisSubmissionQuotaRepository{client: client}, mr
+}
+
+func TestRedisSubmissionQuotaRepository_Increment_WhenDayChanges_ShouldStartNewCountExpiringAtMidnight(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestSubmissionQuotaRepository(t)
+	evening := time.Date(2026, 10, 16, 22, 0, 0, 0, time.UTC)
+	mr.SetTime(evening)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	first, err := repo.Increment(ctx, "user-1", evening)
+	require.NoError(t, err)
+	second, err := repo.Increment(ctx, "user-1", evening.Add(time.Hour))
+	require.NoError(t, err)
+	nextDay, err := repo.Increment(ctx, "user-1", evening.Add(3*time.Hour))
+	require.NoError(t, err)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(2), second)
+	require.Equal(t, int64(1), nextDay)
+	require.Equal(t, 2*time.Hour, mr.TTL(domain.RedisSubmissionQuotaKeyPrefix+"2026-10-16:user-1"))
+}
+
+func TestRedisSubmissionQuotaRepository_Decrement_WhenSubmissionFailed_ShouldTakeItBack(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo, mr := newTestSubmissionQuotaRepository(t)
+	evening := time.Date(2026, 10, 16, 22, 0, 0, 0, time.UTC)
+	mr.SetTime(evening)
+	_, err := repo.Increment(ctx, "user-1", evening)
+	require.NoError(t, err)
+	_, err = repo.Increment(ctx, "user-1", evening)
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err = repo.Decrement(ctx, "user-1", evening)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	count, err := repo.Increment(ctx, "user-1", evening)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+	require.Equal(t, 2*time.Hour, mr.TTL(domain.RedisSubmissionQuotaKeyPrefix+"2026-10-16:user-1"))
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisViewerPresenceRepository implements ViewerPresenceRepository with a Redis sorted set.
+// Members are connection IDs scored by their expiry time, so the count is never negative and
+// connections that stop heartbeating drop out without an explicit Leave.
+type RedisViewerPresenceRepository struct {
+	client *redis.Client
+	now    func() time.Time
+}
+
+// NewRedisViewerPresenceRepository creates a new Redis viewer presence repository
+func NewRedisViewerPresenceRepository(client *redis.Client) application.ViewerPresenceRepository {
+	return &RedisViewerPresenceRepository{client: client, now: time.Now}
+}
+
+// Touch marks the connection present until ttl from now and returns the viewer count
+func (r *RedisViewerPresenceRepository) Touch(ctx context.Context, connectionID string, ttl time.Duration) (int64, error) {
+	now := r.now()
+	pipe := r.client.TxPipeline()
+	pipe.ZAdd(ctx, domain.RedisViewerPresenceKey, redis.Z{
+		Score:  float64(now.Add(ttl).UnixMilli()),
+		Member: connectionID,
+	})
+	// Drop the whole set if every server stops heartbeating
+	pipe.Expire(ctx, domain.RedisViewerPresenceKey, ttl)
+	countCmd := r.countLive(ctx, pipe, now)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to record viewer presence: %w", err)
+	}
+
+	return countCmd.Val(), nil
+}
+
+// Leave removes the connection and returns the viewer count
+func (r *RedisViewerPresenceRepository) Leave(ctx context.Context, connectionID string) (int64, error) {
+	pipe := r.client.TxPipeline()
+	pipe.ZRem(ctx, domain.RedisViewerPresenceKey, connectionID)
+	countCmd := r.countLive(ctx, pipe, r.now())
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to remove viewer presence: %w", err)
+	}
+
+	return countCmd.Val(), nil
+}
+
+// Count returns the number of connections whose presence has not expired
+func (r *RedisViewerPresenceRepository) Count(ctx context.Context) (int64, error) {
+	count, err := r.countLive(ctx, r.client, r.now()).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count viewers: %w", err)
+	}
+
+	return count, nil
+}
+
+// countLive prunes expired connections and queues a count of the remaining ones on c (the client or a pipeline)
+func (r *RedisViewerPresenceRepository) countLive(ctx context.Context, c redis.Cmdable, now time.Time) *redis.IntCmd {
+	c.ZRemRangeByScore(ctx, domain.RedisViewerPresenceKey, "-inf", strconv.FormatInt(now.UnixMilli(), 10))
+	return c.ZCard(ctx, domain.RedisViewerPresenceKey)
+}
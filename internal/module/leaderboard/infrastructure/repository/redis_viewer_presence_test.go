@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestViewerPresenceRepository(t *testing.T) *RedisViewerPresenceRepository {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	return &RedisViewerPresenceRepository{client: client, now: time.Now}
+}
+
+func TestRedisViewerPresenceRepository_WhenViewersConnectAndDisconnect_ShouldAdjustCountWithoutGoingNegative(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo := newTestViewerPresenceRepository(t)
+
+	// ── Act & Assert ────────────────────────────────────────────────────
+	count, err := repo.Touch(ctx, "conn-1", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	count, err = repo.Touch(ctx, "conn-2", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	// A heartbeat from an existing connection does not double count
+	count, err = repo.Touch(ctx, "conn-1", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	count, err = repo.Leave(ctx, "conn-1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	count, err = repo.Leave(ctx, "conn-2")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), count)
+
+	// Duplicate disconnects never push the count below zero
+	count, err = repo.Leave(ctx, "conn-2")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), count)
+
+	count, err = repo.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), count)
+}
+
+func TestRedisViewerPresenceRepository_Count_WhenHeartbeatsStop_ShouldExpirePresence(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	repo := newTestViewerPresenceRepository(t)
+	start := time.Now()
+	repo.now = func() time.Time { return start }
+
+	_, err := repo.Touch(ctx, "crashed", 30*time.Second)
+	require.NoError(t, err)
+	repo.now = func() time.Time { return start.Add(20 * time.Second) }
+	_, err = repo.Touch(ctx, "alive", 30*time.Second)
+	require.NoError(t, err)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	repo.now = func() time.Time { return start.Add(31 * time.Second) }
+	count, err := repo.Count(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
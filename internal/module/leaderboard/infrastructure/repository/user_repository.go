@@ -6,7 +6,9 @@ import (
 	"fmt"
 
 	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/shared/database"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -29,7 +31,13 @@ func (r *PostgresUserRepository) GetByIDs(ctx context.Context, userIDs []string)
 
 	query := `SELECT id, username FROM users WHERE id = ANY($1)`
 
-	rows, err := r.pool.Query(ctx, query, userIDs)
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by IDs: %w", err)
+	}
+	defer release()
+
+	rows, err := r.pool.Query(database.WithQueryName(ctx, "GetUsernames"), query, userIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get users by IDs: %w", err)
 	}
@@ -50,3 +58,24 @@ func (r *PostgresUserRepository) GetByIDs(ctx context.Context, userIDs []string)
 
 	return result, nil
 }
+
+// IsEmailVerified reports whether the user has confirmed their email address
+func (r *PostgresUserRepository) IsEmailVerified(ctx context.Context, userID string) (bool, error) {
+	query := `SELECT email_verified FROM users WHERE id = $1`
+
+	release, err := database.AcquireQuery(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get email verification status: %w", err)
+	}
+	defer release()
+
+	var verified bool
+	if err := r.pool.QueryRow(database.WithQueryName(ctx, "IsEmailVerified"), query, userID).Scan(&verified); err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get email verification status: %w", err)
+	}
+
+	return verified, nil
+}
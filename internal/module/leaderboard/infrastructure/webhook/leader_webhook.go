@@ -0,0 +1,108 @@
+// Package webhook provides HTTP webhook notifier implementations for the leaderboard module.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"real-time-leaderboard/internal/module/leaderboard/application"
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultBaseDelay   = time.Second
+	requestTimeout     = 5 * time.Second
+)
+
+// LeaderWebhookNotifier implements LeaderNotifier by POSTing new-leader events to a webhook URL
+type LeaderWebhookNotifier struct {
+	url         string
+	client      *http.Client
+	maxAttempts int
+	baseDelay   time.Duration
+	logger      *logger.Logger
+}
+
+// NewLeaderWebhookNotifier creates a new webhook notifier.
+// Non-positive maxAttempts or baseDelay fall back to defaults.
+func NewLeaderWebhookNotifier(
+	url string,
+	maxAttempts int,
+	baseDelay time.Duration,
+	logger *logger.Logger,
+) application.LeaderNotifier {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	if baseDelay <= 0 {
+		baseDelay = defaultBaseDelay
+	}
+	return &LeaderWebhookNotifier{
+		url:         url,
+		client:      &http.Client{Timeout: requestTimeout},
+		maxAttempts: maxAttempts,
+		baseDelay:   baseDelay,
+		logger:      logger,
+	}
+}
+
+// NotifyNewLeader delivers the event in the background and returns immediately.
+// Delivery is retried with exponential backoff; final failure is only logged.
+func (n *LeaderWebhookNotifier) NotifyNewLeader(ctx context.Context, event *domain.NewLeaderEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal new leader event: %w", err)
+	}
+
+	// Detach from the request so delivery outlives the response, keeping request-scoped values for logging
+	bgCtx := context.WithoutCancel(ctx)
+	go n.deliver(bgCtx, payload)
+
+	return nil
+}
+
+func (n *LeaderWebhookNotifier) deliver(ctx context.Context, payload []byte) {
+	delay := n.baseDelay
+	for attempt := 1; attempt <= n.maxAttempts; attempt++ {
+		err := n.post(ctx, payload)
+		if err == nil {
+			return
+		}
+
+		if attempt == n.maxAttempts {
+			n.logger.Errorf(ctx, "Failed to deliver new leader webhook after %d attempts: %v", attempt, err)
+			return
+		}
+
+		n.logger.Warnf(ctx, "Failed to deliver new leader webhook (attempt %d/%d), retrying in %s: %v", attempt, n.maxAttempts, delay, err)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func (n *LeaderWebhookNotifier) post(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
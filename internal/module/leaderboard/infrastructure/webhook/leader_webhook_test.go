@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/module/leaderboard/domain"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+func TestLeaderWebhookNotifier_NotifyNewLeader_WhenWebhookFailsOnce_ShouldRetryAndDeliverPayload(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	var calls atomic.Int32
+	received := make(chan domain.NewLeaderEvent, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var event domain.NewLeaderEvent
+		_ = json.NewDecoder(r.Body).Decode(&event)
+		received <- event
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := NewLeaderWebhookNotifier(server.URL, 3, time.Millisecond, logger.New("info", false))
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	event := &domain.NewLeaderEvent{UserID: "user-1", Username: "alice", Score: 5000, BoardID: domain.GlobalBoardID, At: at}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := notifier.NotifyNewLeader(context.Background(), event)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	select {
+	case got := <-received:
+		require.Equal(t, *event, got)
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+	require.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,31 @@
+package database
+
+import "context"
+
+// queryLimitKey is the context key of the per-request query semaphore
+type queryLimitKey struct{}
+
+// WithQueryLimit returns a context whose queries, gated by AcquireQuery, run at most limit at a time.
+// A non-positive limit returns ctx unchanged so callers can disable the bound.
+func WithQueryLimit(ctx context.Context, limit int) context.Context {
+	if limit <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, queryLimitKey{}, make(chan struct{}, limit))
+}
+
+// AcquireQuery waits for a query slot of the limit set on ctx and returns the func that frees it.
+// Without a limit on ctx it returns immediately; it fails with ctx's error when ctx ends while waiting.
+func AcquireQuery(ctx context.Context) (release func(), err error) {
+	slots, ok := ctx.Value(queryLimitKey{}).(chan struct{})
+	if !ok {
+		return func() {}, nil
+	}
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
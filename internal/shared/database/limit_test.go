@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireQuery_WhenManyQueriesShareContext_ShouldNeverExceedLimit(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	const limit = 3
+	ctx := WithQueryLimit(context.Background(), limit)
+
+	var running, peak atomic.Int64
+	var wg sync.WaitGroup
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			release, err := AcquireQuery(ctx)
+			require.NoError(t, err)
+			defer release()
+
+			now := running.Add(1)
+			for {
+				seen := peak.Load()
+				if now <= seen || peak.CompareAndSwap(seen, now) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.LessOrEqual(t, peak.Load(), int64(limit))
+	require.Positive(t, peak.Load())
+}
+
+func TestAcquireQuery_WhenContextEndsWhileWaiting_ShouldReturnContextError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx, cancel := context.WithTimeout(WithQueryLimit(context.Background(), 1), 20*time.Millisecond)
+	defer cancel()
+
+	release, err := AcquireQuery(ctx)
+	require.NoError(t, err)
+	defer release()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err = AcquireQuery(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestAcquireQuery_WhenNoLimit_ShouldNotBlock(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := WithQueryLimit(context.Background(), 0)
+
+	// ── Act / Assert ────────────────────────────────────────────────────
+	for range 10 {
+		_, err := AcquireQuery(ctx)
+		require.NoError(t, err)
+	}
+}
@@ -14,6 +14,7 @@ import (
 // Postgres represents a PostgreSQL connection pool
 type Postgres struct {
 	Pool   *pgxpool.Pool
+	tracer *SlowQueryTracer
 	logger *logger.Logger
 }
 
@@ -39,6 +40,12 @@ func NewPostgres(cfg config.DatabaseConfig, l *logger.Logger) (*Postgres, error)
 	poolConfig.MaxConnLifetime = cfg.ConnMaxLifetime
 	poolConfig.HealthCheckPeriod = 1 * time.Minute
 
+	// Log and count queries at or above DB_SLOW_QUERY_THRESHOLD
+	tracer := NewSlowQueryTracer(cfg.SlowQueryThreshold, l)
+	if tracer != nil {
+		poolConfig.ConnConfig.Tracer = tracer
+	}
+
 	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection pool: %w", err)
@@ -57,6 +64,7 @@ func NewPostgres(cfg config.DatabaseConfig, l *logger.Logger) (*Postgres, error)
 
 	return &Postgres{
 		Pool:   pool,
+		tracer: tracer,
 		logger: l,
 	}, nil
 }
@@ -73,3 +81,8 @@ func (p *Postgres) Close() {
 func (p *Postgres) Health(ctx context.Context) error {
 	return p.Pool.Ping(ctx)
 }
+
+// SlowQueries returns how many queries the pool has reported slow since startup; 0 when slow queries are not traced
+func (p *Postgres) SlowQueries() int64 {
+	return p.tracer.SlowQueries()
+}
@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// maxUnnamedQueryLen bounds how much SQL is logged for a query without a name
+const maxUnnamedQueryLen = 80
+
+// queryNameKey is the context key of the query name reported by SlowQueryTracer
+type queryNameKey struct{}
+
+// traceStartKey is the context key carrying a traced query's name and start time from start to end
+type traceStartKey struct{}
+
+type traceStart struct {
+	name string
+	at   time.Time
+}
+
+// WithQueryName returns a context whose queries are reported under name by SlowQueryTracer.
+// Queries without a name are reported by the start of their SQL.
+func WithQueryName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, queryNameKey{}, name)
+}
+
+// SlowQueryTracer is a pgx query tracer that logs and counts queries taking at least a threshold
+type SlowQueryTracer struct {
+	threshold time.Duration
+	logger    *logger.Logger
+	count     atomic.Int64
+	now       func() time.Time
+}
+
+// NewSlowQueryTracer creates a tracer reporting queries that take at least threshold.
+// A non-positive threshold returns nil, which leaves the pool untraced.
+func NewSlowQueryTracer(threshold time.Duration, l *logger.Logger) *SlowQueryTracer {
+	if threshold <= 0 {
+		return nil
+	}
+	return &SlowQueryTracer{threshold: threshold, logger: l, now: time.Now}
+}
+
+// TraceQueryStart records the query's name and start time on ctx
+func (t *SlowQueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	name, ok := ctx.Value(queryNameKey{}).(string)
+	if !ok || name == "" {
+		name = summarizeSQL(data.SQL)
+	}
+	return context.WithValue(ctx, traceStartKey{}, traceStart{name: name, at: t.now()})
+}
+
+// TraceQueryEnd logs a warning and bumps the slow-query count when the query took at least the threshold
+func (t *SlowQueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(traceStartKey{}).(traceStart)
+	if !ok {
+		return
+	}
+
+	elapsed := t.now().Sub(start.at)
+	if elapsed < t.threshold {
+		return
+	}
+
+	fields := map[string]interface{}{
+		"query":        start.name,
+		"duration_ms":  elapsed.Milliseconds(),
+		"threshold_ms": t.threshold.Milliseconds(),
+		"slow_queries": t.count.Add(1),
+	}
+	if data.Err != nil {
+		fields["error"] = data.Err.Error()
+	}
+	t.logger.WithFields(fields).Warn(ctx, "Slow query")
+}
+
+// SlowQueries returns how many queries have been reported slow since startup
+func (t *SlowQueryTracer) SlowQueries() int64 {
+	if t == nil {
+		return 0
+	}
+	return t.count.Load()
+}
+
+// summarizeSQL collapses whitespace in sql and truncates it for logging
+func summarizeSQL(sql string) string {
+	summary := strings.Join(strings.Fields(sql), " ")
+	if len(summary) > maxUnnamedQueryLen {
+		summary = summary[:maxUnnamedQueryLen] + "..."
+	}
+	return summary
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// runTracedQuery drives tracer around a fake query that takes elapsed on tracer's clock
+func runTracedQuery(ctx context.Context, tracer *SlowQueryTracer, sql string, elapsed time.Duration, queryErr error) {
+	now := time.Now()
+	tracer.now = func() time.Time { return now }
+
+	ctx = tracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: sql})
+	now = now.Add(elapsed)
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: queryErr})
+}
+
+func TestSlowQueryTracer_WhenQueryReachesThreshold_ShouldLogNameAndDuration(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	var buf bytes.Buffer
+	tracer := NewSlowQueryTracer(100*time.Millisecond, logger.NewWithOptions(logger.Options{Level: "info", Format: logger.FormatJSON, Output: &buf}))
+	ctx := WithQueryName(logger.WithRequestIDContext(context.Background(), "req-1"), "GetLeaderboard")
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	runTracedQuery(ctx, tracer, "SELECT 1", 250*time.Millisecond, nil)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	out := buf.String()
+	require.Contains(t, out, `"message":"Slow query"`)
+	require.Contains(t, out, `"query":"GetLeaderboard"`)
+	require.Contains(t, out, `"duration_ms":250`)
+	require.Contains(t, out, `"request_id":"req-1"`)
+	require.Equal(t, int64(1), tracer.SlowQueries())
+}
+
+func TestSlowQueryTracer_WhenQueryUnderThreshold_ShouldNotLogOrCount(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	var buf bytes.Buffer
+	tracer := NewSlowQueryTracer(100*time.Millisecond, logger.NewWithOptions(logger.Options{Level: "info", Format: logger.FormatJSON, Output: &buf}))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	runTracedQuery(context.Background(), tracer, "SELECT 1", 99*time.Millisecond, nil)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Empty(t, buf.String())
+	require.Zero(t, tracer.SlowQueries())
+}
+
+func TestSlowQueryTracer_WhenQueryUnnamed_ShouldLogCollapsedTruncatedSQL(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	var buf bytes.Buffer
+	tracer := NewSlowQueryTracer(time.Millisecond, logger.NewWithOptions(logger.Options{Level: "info", Format: logger.FormatJSON, Output: &buf}))
+	sql := "\n\t\tSELECT user_id,\n\t\t\tscore\n\t\tFROM leaderboard WHERE " + strings.Repeat("score > 0 AND ", 20) + "TRUE"
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	runTracedQuery(context.Background(), tracer, sql, time.Second, errors.New("canceling statement"))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	out := buf.String()
+	require.Contains(t, out, `"query":"SELECT user_id, score FROM leaderboard WHERE score > 0 AND`)
+	require.Contains(t, out, `..."`)
+	require.Contains(t, out, `"error":"canceling statement"`)
+	require.Equal(t, int64(1), tracer.SlowQueries())
+}
+
+func TestNewSlowQueryTracer_WhenThresholdZero_ShouldReturnNil(t *testing.T) {
+	// ── Act / Assert ────────────────────────────────────────────────────
+	tracer := NewSlowQueryTracer(0, logger.New("info", false))
+	require.Nil(t, tracer)
+	require.Zero(t, tracer.SlowQueries())
+}
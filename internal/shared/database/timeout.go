@@ -0,0 +1,15 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// WithQueryTimeout bounds ctx by timeout for repository calls.
+// A non-positive timeout returns ctx unchanged so callers can disable the limit.
+func WithQueryTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,75 @@
+package lock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// releaseTimeout bounds the lease release when leadership ends on shutdown
+const releaseTimeout = 5 * time.Second
+
+// RunAsLeader runs job only while this instance holds the lease, until ctx is cancelled.
+// Followers retry acquiring every ttl/3 and the leader renews at the same pace. When a renewal
+// fails, the job's context is cancelled and the instance goes back to following, so another
+// instance can take over once the lease expires.
+func RunAsLeader(ctx context.Context, l *Lock, job func(ctx context.Context), log *logger.Logger) {
+	interval := max(l.ttl/3, time.Millisecond)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		acquired, err := l.TryAcquire(ctx)
+		if err != nil {
+			log.Warnf(ctx, "Leader election failed: %v", err)
+		}
+		if acquired {
+			log.Infof(ctx, "Acquired leadership for %s", l.key)
+			lead(ctx, l, job, ticker, log)
+			releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+			if err := l.Release(releaseCtx); err != nil {
+				log.Warnf(ctx, "Failed to release leadership: %v", err)
+			}
+			cancel()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead runs job and renews the lease on every tick until ctx is cancelled or the lease is lost
+func lead(ctx context.Context, l *Lock, job func(ctx context.Context), ticker *time.Ticker, log *logger.Logger) {
+	jobCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		job(jobCtx)
+	}()
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			renewed, err := l.Renew(ctx)
+			if err != nil {
+				log.Warnf(ctx, "Failed to renew leadership: %v", err)
+			}
+			if !renewed {
+				log.Warnf(ctx, "Lost leadership for %s", l.key)
+				return
+			}
+		}
+	}
+}
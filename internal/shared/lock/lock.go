@@ -0,0 +1,73 @@
+// Package lock provides a Redis lease lock and leader election for jobs that must run on one instance.
+package lock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// renewScript extends the lease (KEYS[1]) by ARGV[2] ms only while it is still held with token ARGV[1]
+var renewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript deletes the lease (KEYS[1]) only while it is still held with token ARGV[1],
+// so an instance whose lease already expired cannot release another instance's lease
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// Lock is a lease on a Redis key held by one instance at a time.
+// The lease expires after ttl unless renewed, so a crashed holder frees it on its own.
+type Lock struct {
+	client redis.Cmdable
+	key    string
+	token  string
+	ttl    time.Duration
+}
+
+// New creates a lock on key with a random token identifying this holder
+func New(client redis.Cmdable, key string, ttl time.Duration) *Lock {
+	return &Lock{
+		client: client,
+		key:    key,
+		token:  uuid.New().String(),
+		ttl:    ttl,
+	}
+}
+
+// TryAcquire takes the lease if it is free and reports whether this holder now owns it
+func (l *Lock) TryAcquire(ctx context.Context) (bool, error) {
+	ok, err := l.client.SetNX(ctx, l.key, l.token, l.ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock %s: %w", l.key, err)
+	}
+	return ok, nil
+}
+
+// Renew extends the lease by ttl and reports whether this holder still owns it
+func (l *Lock) Renew(ctx context.Context) (bool, error) {
+	renewed, err := renewScript.Run(ctx, l.client, []string{l.key}, l.token, l.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to renew lock %s: %w", l.key, err)
+	}
+	return renewed == 1, nil
+}
+
+// Release gives up the lease if this holder still owns it
+func (l *Lock) Release(ctx context.Context) error {
+	if err := releaseScript.Run(ctx, l.client, []string{l.key}, l.token).Err(); err != nil {
+		return fmt.Errorf("failed to release lock %s: %w", l.key, err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package lock
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+const testKey = "test:leader"
+
+func newTestClient(t *testing.T) (*redis.Client, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	return client, mr
+}
+
+func TestLock_TryAcquire_WhenAlreadyHeld_ShouldOnlyGrantFirstHolder(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	client, _ := newTestClient(t)
+	first := New(client, testKey, 30*time.Second)
+	second := New(client, testKey, 30*time.Second)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	firstOK, firstErr := first.TryAcquire(ctx)
+	secondOK, secondErr := second.TryAcquire(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, firstErr)
+	require.True(t, firstOK)
+	require.NoError(t, secondErr)
+	require.False(t, secondOK)
+}
+
+func TestLock_Renew_WhenHeld_ShouldExtendLease(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	client, mr := newTestClient(t)
+	holder := New(client, testKey, 30*time.Second)
+	ok, err := holder.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	mr.FastForward(20 * time.Second)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	renewed, err := holder.Renew(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.True(t, renewed)
+	require.Equal(t, 30*time.Second, mr.TTL(testKey))
+}
+
+func TestLock_WhenLeaseExpires_ShouldFailOverToAnotherHolder(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	client, mr := newTestClient(t)
+	crashed := New(client, testKey, 30*time.Second)
+	standby := New(client, testKey, 30*time.Second)
+	ok, err := crashed.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	mr.FastForward(31 * time.Second)
+	takenOver, takeErr := standby.TryAcquire(ctx)
+	staleRenewed, renewErr := crashed.Renew(ctx)
+	releaseErr := crashed.Release(ctx)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, takeErr)
+	require.True(t, takenOver)
+	require.NoError(t, renewErr)
+	require.False(t, staleRenewed)
+	require.NoError(t, releaseErr)
+	require.True(t, mr.Exists(testKey), "a stale holder must not release the new holder's lease")
+}
+
+func TestRunAsLeader_WhenLeaseHeldElsewhere_ShouldRunJobOnlyAfterFailover(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, mr := newTestClient(t)
+	other := New(client, testKey, 30*time.Millisecond)
+	ok, err := other.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	var running atomic.Bool
+	done := make(chan struct{})
+	follower := New(client, testKey, 30*time.Millisecond)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	go func() {
+		defer close(done)
+		RunAsLeader(ctx, follower, func(jobCtx context.Context) {
+			running.Store(true)
+			<-jobCtx.Done()
+			running.Store(false)
+		}, logger.New("info", false))
+	}()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	time.Sleep(50 * time.Millisecond)
+	require.False(t, running.Load(), "job must not run while another instance holds the lease")
+
+	mr.FastForward(time.Second)
+	require.Eventually(t, running.Load, time.Second, 5*time.Millisecond)
+
+	cancel()
+	<-done
+	require.False(t, running.Load())
+	require.False(t, mr.Exists(testKey), "leadership is released on shutdown")
+}
@@ -3,6 +3,7 @@ package logger
 
 import (
 	"context"
+	"io"
 	"os"
 	"time"
 
@@ -11,25 +12,62 @@ import (
 )
 
 // contextKey is a type for context keys to avoid collisions
-type contextKey struct{}
+type contextKey struct{ name string }
 
-var requestIDKey = contextKey{}
+var (
+	requestIDKey = contextKey{"request_id"}
+	userIDKey    = contextKey{"user_id"}
+)
 
 // Logger wraps zerolog.Logger
 type Logger struct {
 	logger zerolog.Logger
 }
 
+const (
+	// FormatJSON writes one structured JSON object per line (production)
+	FormatJSON = "json"
+	// FormatConsole writes human-readable colored output (development)
+	FormatConsole = "console"
+)
+
+// Options configures a logger created by NewWithOptions
+type Options struct {
+	Level  string
+	Format string
+	// SampleRate keeps 1 of every N debug and info messages (0 or 1 disables sampling).
+	// Warnings and errors are never sampled.
+	SampleRate uint32
+	// Output defaults to os.Stderr
+	Output io.Writer
+}
+
 // New creates a new logger instance
 func New(level string, pretty bool) *Logger {
+	format := FormatJSON
+	if pretty {
+		format = FormatConsole
+	}
+	return NewWithOptions(Options{Level: level, Format: format})
+}
+
+// NewWithOptions creates a new logger instance with explicit output format and sampling
+func NewWithOptions(opts Options) *Logger {
 	zerolog.TimeFieldFormat = time.RFC3339
-	zerolog.SetGlobalLevel(parseLevel(level))
+	zerolog.SetGlobalLevel(parseLevel(opts.Level))
 
-	var logger zerolog.Logger
-	if pretty {
-		logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339})
-	} else {
-		logger = log.Logger
+	out := opts.Output
+	if out == nil {
+		out = os.Stderr
+	}
+	if opts.Format == FormatConsole {
+		out = zerolog.ConsoleWriter{Out: out, TimeFormat: time.RFC3339}
+	}
+
+	logger := log.Output(out)
+	if opts.SampleRate > 1 {
+		sampler := &zerolog.BasicSampler{N: opts.SampleRate}
+		logger = logger.Sample(zerolog.LevelSampler{DebugSampler: sampler, InfoSampler: sampler})
 	}
 
 	return &Logger{logger: logger}
@@ -55,13 +93,16 @@ func parseLevel(level string) zerolog.Level {
 	}
 }
 
-// getLogger returns a logger with request ID from context if available
+// getLogger returns a logger with the request ID and user ID from context if available
 func (l *Logger) getLogger(ctx context.Context) zerolog.Logger {
 	log := l.logger
 	if ctx != nil {
 		if requestID := GetRequestIDFromContext(ctx); requestID != "" {
 			log = log.With().Str("request_id", requestID).Logger()
 		}
+		if userID := GetUserIDFromContext(ctx); userID != "" {
+			log = log.With().Str("user_id", userID).Logger()
+		}
 	}
 	return log
 }
@@ -161,3 +202,16 @@ func GetRequestIDFromContext(ctx context.Context) string {
 	}
 	return ""
 }
+
+// WithUserIDContext stores the authenticated user ID in context so every log in the request carries it
+func WithUserIDContext(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// GetUserIDFromContext extracts the authenticated user ID from context
+func GetUserIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(userIDKey).(string); ok {
+		return id
+	}
+	return ""
+}
@@ -0,0 +1,95 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_NewWithOptions_WhenSamplingEnabled_ShouldDropRepeatedInfoButKeepErrors(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	var buf bytes.Buffer
+	l := NewWithOptions(Options{Level: "info", Format: FormatJSON, SampleRate: 3, Output: &buf})
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	for range 9 {
+		l.Info(ctx, "info message")
+	}
+	for range 3 {
+		l.Error(ctx, "error message")
+	}
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	out := buf.String()
+	require.Equal(t, 3, strings.Count(out, `"message":"info message"`))
+	require.Equal(t, 3, strings.Count(out, `"message":"error message"`))
+}
+
+func TestLogger_NewWithOptions_WhenJSONFormat_ShouldWriteStructuredLines(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := WithRequestIDContext(context.Background(), "req-1")
+	var buf bytes.Buffer
+	l := NewWithOptions(Options{Level: "info", Format: FormatJSON, Output: &buf})
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	l.Info(ctx, "hello")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	out := buf.String()
+	require.Contains(t, out, `"level":"info"`)
+	require.Contains(t, out, `"request_id":"req-1"`)
+	require.Contains(t, out, `"message":"hello"`)
+}
+
+func TestOpenOutput_WhenFilePath_ShouldWriteLogLinesToFile(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	path := filepath.Join(t.TempDir(), "logs", "server.log")
+	out, err := OpenOutput(path, RotationOptions{MaxSizeMB: 1})
+	require.NoError(t, err)
+	if closer, ok := out.(io.Closer); ok {
+		t.Cleanup(func() { _ = closer.Close() })
+	}
+	l := NewWithOptions(Options{Level: "info", Format: FormatJSON, Output: out})
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	l.Info(context.Background(), "written to file")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"message":"written to file"`)
+}
+
+func TestOpenOutput_WhenFileNotWritable_ShouldReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	dir := t.TempDir()
+	// A directory cannot be opened as a log file
+	path := filepath.Join(dir, "server.log")
+	require.NoError(t, os.Mkdir(path, 0o755))
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	out, err := OpenOutput(path, RotationOptions{})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.Nil(t, out)
+}
+
+func TestOpenOutput_WhenStandardStream_ShouldReturnIt(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	stdout, err := OpenOutput(OutputStdout, RotationOptions{})
+	require.NoError(t, err)
+	stderr, err := OpenOutput("", RotationOptions{})
+	require.NoError(t, err)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, os.Stdout, stdout)
+	require.Equal(t, os.Stderr, stderr)
+}
@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	// OutputStderr writes logs to standard error (default)
+	OutputStderr = "stderr"
+	// OutputStdout writes logs to standard output
+	OutputStdout = "stdout"
+)
+
+// RotationOptions configures size-based rotation of a file output
+type RotationOptions struct {
+	// MaxSizeMB rotates the file once it reaches this size
+	MaxSizeMB int
+	// MaxBackups is the number of rotated files to keep (0 keeps all)
+	MaxBackups int
+	// MaxAgeDays removes rotated files older than this (0 keeps all)
+	MaxAgeDays int
+	Compress   bool
+}
+
+// OpenOutput resolves a log destination: "stderr" (or empty), "stdout", or a file path.
+// File paths are checked for writability up front and rotated according to rotation.
+func OpenOutput(destination string, rotation RotationOptions) (io.Writer, error) {
+	switch destination {
+	case "", OutputStderr:
+		return os.Stderr, nil
+	case OutputStdout:
+		return os.Stdout, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destination), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+	f, err := os.OpenFile(destination, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("log file is not writable: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close log file: %w", err)
+	}
+
+	return &lumberjack.Logger{
+		Filename:   destination,
+		MaxSize:    rotation.MaxSizeMB,
+		MaxBackups: rotation.MaxBackups,
+		MaxAge:     rotation.MaxAgeDays,
+		Compress:   rotation.Compress,
+	}, nil
+}
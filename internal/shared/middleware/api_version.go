@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"real-time-leaderboard/internal/shared/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+const apiVersionHeader = "X-API-Version"
+
+// APIVersion creates a middleware that reports the API version in the X-API-Version header
+// and in the api_version field of the response envelope
+func APIVersion(version string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(response.APIVersionKey, version)
+		c.Writer.Header().Set(apiVersionHeader, version)
+		c.Next()
+	}
+}
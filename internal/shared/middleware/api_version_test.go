@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/shared/response"
+)
+
+func serveEnvelope(t *testing.T, handlers ...gin.HandlerFunc) (*httptest.ResponseRecorder, response.Response) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(handlers...)
+	router.GET("/ping", func(c *gin.Context) {
+		response.Success(c, gin.H{"pong": true}, "ok")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+	var body response.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return w, body
+}
+
+func TestAPIVersion_WhenEnabled_ShouldSetHeaderAndEnvelopeField(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	w, body := serveEnvelope(t, APIVersion("1.4.0"))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "1.4.0", w.Header().Get("X-API-Version"))
+	require.Equal(t, "1.4.0", body.APIVersion)
+}
+
+func TestAPIVersion_WhenNotInstalled_ShouldOmitHeaderAndEnvelopeField(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	w, body := serveEnvelope(t)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Empty(t, w.Header().Get("X-API-Version"))
+	require.Empty(t, body.APIVersion)
+	require.NotContains(t, w.Body.String(), "api_version")
+}
@@ -19,13 +19,19 @@ const (
 // AuthMiddleware handles authentication
 type AuthMiddleware struct {
 	validateToken func(ctx context.Context, token string) (string, error)
+	isAdmin       func(ctx context.Context, userID string) (bool, error)
 	logger        *logger.Logger
 }
 
 // NewAuthMiddleware creates a new auth middleware
-func NewAuthMiddleware(validateToken func(ctx context.Context, token string) (string, error), l *logger.Logger) *AuthMiddleware {
+func NewAuthMiddleware(
+	validateToken func(ctx context.Context, token string) (string, error),
+	isAdmin func(ctx context.Context, userID string) (bool, error),
+	l *logger.Logger,
+) *AuthMiddleware {
 	return &AuthMiddleware{
 		validateToken: validateToken,
+		isAdmin:       isAdmin,
 		logger:        l,
 	}
 }
@@ -60,11 +66,72 @@ func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 			return
 		}
 
-		c.Set(userIDKey, userID)
+		setUserID(c, userID)
 		c.Next()
 	}
 }
 
+// OptionalAuth is a middleware that identifies the caller when a valid bearer token is present.
+// Anonymous requests and requests with an invalid token continue without a user ID.
+func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if !strings.HasPrefix(authHeader, authHeaderPrefix) {
+			c.Next()
+			return
+		}
+
+		token := strings.TrimPrefix(authHeader, authHeaderPrefix)
+		userID, err := m.validateToken(c.Request.Context(), token)
+		if err != nil {
+			m.logger.Warnf(c.Request.Context(), "Ignoring invalid token on optional auth route: %v", err)
+			c.Next()
+			return
+		}
+
+		setUserID(c, userID)
+		c.Next()
+	}
+}
+
+// RequireAdmin is a middleware that only lets admins through. It must run after RequireAuth.
+func (m *AuthMiddleware) RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := GetUserID(c)
+		if !ok {
+			apiErr := response.NewUnauthorizedError("")
+			m.logger.Error(c.Request.Context(), "user_id missing from context in RequireAdmin")
+			response.Error(c, apiErr)
+			c.Abort()
+			return
+		}
+
+		isAdmin, err := m.isAdmin(c.Request.Context(), userID)
+		if err != nil {
+			apiErr := response.AsAPIError(err)
+			m.logger.Err(c.Request.Context(), err).Msg("Request error")
+			response.Error(c, apiErr)
+			c.Abort()
+			return
+		}
+		if !isAdmin {
+			apiErr := response.NewForbiddenError("Admin access required")
+			m.logger.Warnf(c.Request.Context(), "Non-admin user %s denied admin route", userID)
+			response.Error(c, apiErr)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// setUserID records the authenticated user for handlers and attaches it to the request context for logging
+func setUserID(c *gin.Context, userID string) {
+	c.Set(userIDKey, userID)
+	c.Request = c.Request.WithContext(logger.WithUserIDContext(c.Request.Context(), userID))
+}
+
 // GetUserID retrieves user ID from context
 func GetUserID(c *gin.Context) (string, bool) {
 	userID, exists := c.Get(userIDKey)
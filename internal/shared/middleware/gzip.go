@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Gzip creates a middleware that gzip-compresses responses of at least minSize bytes
+// for clients sending Accept-Encoding: gzip. Server-Sent Event streams and responses
+// flushed before reaching minSize are passed through untouched so they are never buffered.
+func Gzip(minSize int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.Contains(c.GetHeader("Accept-Encoding"), "gzip") {
+			c.Next()
+			return
+		}
+
+		w := &gzipWriter{ResponseWriter: c.Writer, minSize: minSize}
+		c.Writer = w
+		defer w.finish()
+
+		c.Next()
+	}
+}
+
+// gzipWriter buffers the response until it is large enough to be worth compressing
+type gzipWriter struct {
+	gin.ResponseWriter
+	minSize     int
+	buf         bytes.Buffer
+	gz          *gzip.Writer
+	passthrough bool
+}
+
+// Write buffers data until the compression decision can be made
+func (w *gzipWriter) Write(data []byte) (int, error) {
+	if w.passthrough {
+		return w.ResponseWriter.Write(data)
+	}
+	if w.gz != nil {
+		return w.gz.Write(data)
+	}
+	if w.skipCompression() {
+		w.passthrough = true
+		return w.ResponseWriter.Write(data)
+	}
+
+	w.buf.Write(data)
+	if w.buf.Len() >= w.minSize {
+		if err := w.startCompression(); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+// WriteString implements gin.ResponseWriter through the buffering Write
+func (w *gzipWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush sends what is written so far; an undecided response is sent uncompressed
+// since the handler wants it delivered now
+func (w *gzipWriter) Flush() {
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	} else if !w.passthrough {
+		w.passthrough = true
+		w.writeBuffered()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// skipCompression reports whether the response must not be compressed
+func (w *gzipWriter) skipCompression() bool {
+	header := w.Header()
+	return strings.HasPrefix(header.Get("Content-Type"), "text/event-stream") ||
+		header.Get("Content-Encoding") != ""
+}
+
+// startCompression switches to gzip output and writes the buffered bytes through it
+func (w *gzipWriter) startCompression() error {
+	header := w.Header()
+	header.Set("Content-Encoding", "gzip")
+	header.Add("Vary", "Accept-Encoding")
+	header.Del("Content-Length")
+
+	w.gz = gzip.NewWriter(w.ResponseWriter)
+	_, err := w.gz.Write(w.buf.Bytes())
+	w.buf.Reset()
+	return err
+}
+
+// writeBuffered sends buffered bytes uncompressed
+func (w *gzipWriter) writeBuffered() {
+	if w.buf.Len() > 0 {
+		_, _ = w.ResponseWriter.Write(w.buf.Bytes())
+		w.buf.Reset()
+	}
+}
+
+// finish completes the response once the handler chain returns
+func (w *gzipWriter) finish() {
+	if w.gz != nil {
+		_ = w.gz.Close()
+		return
+	}
+	w.writeBuffered()
+}
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newGzipRouter(minSize int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Gzip(minSize))
+	router.GET("/large", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": strings.Repeat("a", 4096)})
+	})
+	router.GET("/small", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+	router.GET("/stream", func(c *gin.Context) {
+		c.Header("Content-Type", "text/event-stream")
+		for i := 0; i < 3; i++ {
+			c.SSEvent("update", strings.Repeat("b", 1024))
+			c.Writer.Flush()
+		}
+	})
+	return router
+}
+
+func serveGzip(router *gin.Engine, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestGzip_WhenResponseAboveMinSize_ShouldCompress(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	router := newGzipRouter(1024)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	w := serveGzip(router, "/large")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	require.Less(t, w.Body.Len(), 4096)
+
+	gr, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(gr)
+	require.NoError(t, err)
+	require.Contains(t, string(body), strings.Repeat("a", 4096))
+}
+
+func TestGzip_WhenResponseBelowMinSize_ShouldLeaveUncompressed(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	router := newGzipRouter(1024)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	w := serveGzip(router, "/small")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, w.Header().Get("Content-Encoding"))
+	require.JSONEq(t, `{"ok":true}`, w.Body.String())
+}
+
+func TestGzip_WhenEventStream_ShouldPassThroughUncompressed(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	router := newGzipRouter(512)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	w := serveGzip(router, "/stream")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Empty(t, w.Header().Get("Content-Encoding"))
+	require.Equal(t, 3, strings.Count(w.Body.String(), "event:update"))
+}
+
+func TestGzip_WhenClientDoesNotAcceptGzip_ShouldLeaveUncompressed(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	router := newGzipRouter(1024)
+	req := httptest.NewRequest(http.MethodGet, "/large", nil)
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, req)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Empty(t, w.Header().Get("Content-Encoding"))
+	require.Contains(t, w.Body.String(), strings.Repeat("a", 4096))
+}
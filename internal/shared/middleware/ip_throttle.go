@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"real-time-leaderboard/internal/shared/logger"
+	"real-time-leaderboard/internal/shared/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// RateLimitLimitHeader carries how many requests the client may make per window
+	RateLimitLimitHeader = "X-RateLimit-Limit"
+	// RateLimitRemainingHeader carries how many requests the client has left in the current window
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	// RateLimitResetHeader carries the unix time in seconds at which the current window ends
+	RateLimitResetHeader = "X-RateLimit-Reset"
+)
+
+// IPThrottleMiddleware limits how many requests each client IP may make per fixed time window
+type IPThrottleMiddleware struct {
+	limit     int64
+	window    time.Duration
+	increment func(ctx context.Context, ip string, windowEnd time.Time) (int64, error)
+	now       func() time.Time
+	logger    *logger.Logger
+}
+
+// NewIPThrottleMiddleware creates an IP throttle middleware allowing limit requests per IP in each window.
+// Windows are aligned to the clock (every hour on the hour for a one-hour window). increment counts a request
+// from ip in the window ending at windowEnd and returns the new count, so the limit holds across instances.
+func NewIPThrottleMiddleware(
+	limit int64,
+	window time.Duration,
+	increment func(ctx context.Context, ip string, windowEnd time.Time) (int64, error),
+	l *logger.Logger,
+) *IPThrottleMiddleware {
+	return &IPThrottleMiddleware{
+		limit:     limit,
+		window:    window,
+		increment: increment,
+		now:       time.Now,
+		logger:    l,
+	}
+}
+
+// Throttle is a middleware that answers 429, with Retry-After set to the end of the window, once the client IP
+// has used up its requests. Every counted request, allowed or not, gets the X-RateLimit-* headers.
+// If the count cannot be read the request is let through without them, so an outage of the counter store
+// does not block the route.
+func (m *IPThrottleMiddleware) Throttle() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		now := m.now()
+		windowEnd := now.Truncate(m.window).Add(m.window)
+
+		count, err := m.increment(ctx, c.ClientIP(), windowEnd)
+		if err != nil {
+			m.logger.Warnf(ctx, "Failed to count request for IP throttle, letting it through: %v", err)
+			c.Next()
+			return
+		}
+		c.Header(RateLimitLimitHeader, strconv.FormatInt(m.limit, 10))
+		c.Header(RateLimitRemainingHeader, strconv.FormatInt(max(m.limit-count, 0), 10))
+		c.Header(RateLimitResetHeader, strconv.FormatInt(windowEnd.Unix(), 10))
+		if count > m.limit {
+			apiErr := response.NewTooManyRequestsError("Too many requests from this address, try again later", windowEnd.Sub(now))
+			m.logger.Warn(ctx, apiErr.Error())
+			response.Error(c, apiErr)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
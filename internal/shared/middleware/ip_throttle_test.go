@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// newThrottleTestRouter serves POST /register behind Throttle, allowing limit requests per IP and hour at now.
+// Counts are kept in memory per IP and window for the lifetime of the router.
+func newThrottleTestRouter(limit int64, now time.Time) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	var mu sync.Mutex
+	counts := make(map[string]int64)
+	increment := func(_ context.Context, ip string, windowEnd time.Time) (int64, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := windowEnd.String() + "|" + ip
+		counts[key]++
+		return counts[key], nil
+	}
+
+	m := NewIPThrottleMiddleware(limit, time.Hour, increment, logger.New("info", false))
+	m.now = func() time.Time { return now }
+
+	router := gin.New()
+	router.POST("/register", m.Throttle(), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+	return router
+}
+
+// newRequestFrom builds a POST /register request from remoteAddr
+func newRequestFrom(remoteAddr string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/register", nil)
+	req.RemoteAddr = remoteAddr
+	return req
+}
+
+func TestIPThrottleMiddleware_Throttle_WhenLimitReached_ShouldRejectNextRequestWith429(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	now := time.Date(2026, 10, 16, 12, 45, 0, 0, time.UTC)
+	router := newThrottleTestRouter(2, now)
+	for range 2 {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newRequestFrom("203.0.113.7:4000"))
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, newRequestFrom("203.0.113.7:4001"))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Contains(t, w.Body.String(), "TOO_MANY_REQUESTS")
+	require.Equal(t, "900", w.Header().Get("Retry-After"))
+}
+
+func TestIPThrottleMiddleware_Throttle_WhenOtherIPReachedLimit_ShouldAllowRequest(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	now := time.Date(2026, 10, 16, 12, 45, 0, 0, time.UTC)
+	router := newThrottleTestRouter(1, now)
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, newRequestFrom("203.0.113.7:4000"))
+	require.Equal(t, http.StatusCreated, first.Code)
+
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, newRequestFrom("198.51.100.9:4000"))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestIPThrottleMiddleware_Throttle_WhenCountFails_ShouldLetRequestThrough(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	failing := func(context.Context, string, time.Time) (int64, error) {
+		return 0, errors.New("redis unavailable")
+	}
+	m := NewIPThrottleMiddleware(1, time.Hour, failing, logger.New("info", false))
+	router := gin.New()
+	router.POST("/register", m.Throttle(), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, newRequestFrom("203.0.113.7:4000"))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Empty(t, w.Header().Get(RateLimitRemainingHeader))
+}
+
+func TestIPThrottleMiddleware_Throttle_WhenRequestsCounted_ShouldDecrementRateLimitHeaders(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	now := time.Date(2026, 10, 16, 12, 45, 0, 0, time.UTC)
+	router := newThrottleTestRouter(2, now)
+	reset := strconv.FormatInt(time.Date(2026, 10, 16, 13, 0, 0, 0, time.UTC).Unix(), 10)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	responses := make([]*httptest.ResponseRecorder, 3)
+	for i := range responses {
+		responses[i] = httptest.NewRecorder()
+		router.ServeHTTP(responses[i], newRequestFrom("203.0.113.7:4000"))
+	}
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	for i, wantRemaining := range []string{"1", "0", "0"} {
+		require.Equal(t, "2", responses[i].Header().Get(RateLimitLimitHeader))
+		require.Equal(t, wantRemaining, responses[i].Header().Get(RateLimitRemainingHeader), "request %d", i+1)
+		require.Equal(t, reset, responses[i].Header().Get(RateLimitResetHeader))
+	}
+	require.Equal(t, http.StatusTooManyRequests, responses[2].Code)
+}
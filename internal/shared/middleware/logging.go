@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"real-time-leaderboard/internal/shared/logger"
@@ -9,9 +10,15 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// RequestLogger creates a logging middleware
-func RequestLogger(l *logger.Logger) gin.HandlerFunc {
+// RequestLogger creates a logging middleware.
+// Requests whose path starts with one of skipPrefixes (e.g. health checks) are not logged.
+func RequestLogger(l *logger.Logger, skipPrefixes ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if hasAnyPrefix(c.Request.URL.Path, skipPrefixes) {
+			c.Next()
+			return
+		}
+
 		start := time.Now()
 		c.Next()
 
@@ -29,3 +36,13 @@ func RequestLogger(l *logger.Logger) gin.HandlerFunc {
 		}
 	}
 }
+
+// hasAnyPrefix reports whether path starts with any of prefixes
+func hasAnyPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if prefix != "" && strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+func serveLoggedRequest(t *testing.T, trustedProxies []string) string {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	var buf bytes.Buffer
+	l := logger.NewWithOptions(logger.Options{Level: "info", Format: logger.FormatJSON, Output: &buf})
+
+	router := gin.New()
+	require.NoError(t, router.SetTrustedProxies(trustedProxies))
+	router.Use(RequestLogger(l))
+	router.GET("/ping", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.RemoteAddr = "10.0.0.5:51234"
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	return buf.String()
+}
+
+func TestRequestLogger_WhenRemoteIsTrustedProxy_ShouldLogForwardedClientIP(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	out := serveLoggedRequest(t, []string{"10.0.0.0/8"})
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Contains(t, out, "203.0.113.7")
+	require.NotContains(t, out, "10.0.0.5")
+}
+
+func TestRequestLogger_WhenRemoteIsNotTrustedProxy_ShouldIgnoreForwardedHeader(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	out := serveLoggedRequest(t, nil)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Contains(t, out, "10.0.0.5")
+	require.NotContains(t, out, "203.0.113.7")
+}
+
+func serveSkippableRequest(t *testing.T, path string) string {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	var buf bytes.Buffer
+	l := logger.NewWithOptions(logger.Options{Level: "info", Format: logger.FormatJSON, Output: &buf})
+
+	router := gin.New()
+	router.Use(RequestLogger(l, "/health", "/metrics"))
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	router.GET("/api/v1/leaderboard", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+
+	return buf.String()
+}
+
+func TestRequestLogger_WhenPathIsSkipped_ShouldNotLog(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	out := serveSkippableRequest(t, "/health")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Empty(t, out)
+}
+
+func TestRequestLogger_WhenPathIsNotSkipped_ShouldLog(t *testing.T) {
+	// ── Act ─────────────────────────────────────────────────────────────
+	out := serveSkippableRequest(t, "/api/v1/leaderboard")
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Contains(t, out, "/api/v1/leaderboard")
+}
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"real-time-leaderboard/internal/shared/database"
+
+	"github.com/gin-gonic/gin"
+)
+
+// QueryLimit creates a middleware that lets each request run at most limit database queries at once,
+// so one request fanning out queries cannot exhaust the connection pool (0 disables)
+func QueryLimit(limit int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(database.WithQueryLimit(c.Request.Context(), limit))
+		c.Next()
+	}
+}
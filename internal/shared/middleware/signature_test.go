@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+const testSigningSecret = "test-signing-secret"
+
+// newSignatureTestRouter serves PUT /score behind RequireSignature, echoing the body the handler receives.
+// Nonces are remembered in memory for the lifetime of the router.
+func newSignatureTestRouter(now time.Time) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	var mu sync.Mutex
+	used := make(map[string]bool)
+	reserve := func(_ context.Context, nonce string, _ time.Duration) (bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if used[nonce] {
+			return false, nil
+		}
+		used[nonce] = true
+		return true, nil
+	}
+
+	m := NewSignatureMiddleware(testSigningSecret, 5*time.Minute, reserve, logger.New("info", false))
+	m.now = func() time.Time { return now }
+
+	router := gin.New()
+	router.PUT("/score", m.RequireSignature(), func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, string(body))
+	})
+	return router
+}
+
+// newSignedRequest builds a PUT /score request signed at signedAt with nonce
+func newSignedRequest(body, nonce string, signedAt time.Time) *http.Request {
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	req := httptest.NewRequest(http.MethodPut, "/score", strings.NewReader(body))
+	req.Header.Set(SignatureTimestampHeader, timestamp)
+	req.Header.Set(SignatureNonceHeader, nonce)
+	req.Header.Set(SignatureHeader, Sign(testSigningSecret, timestamp, nonce, []byte(body)))
+	return req
+}
+
+func TestSignatureMiddleware_RequireSignature_WhenValidlySigned_ShouldPassBodyThrough(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	router := newSignatureTestRouter(now)
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, newSignedRequest(`{"score":100}`, "nonce-1", now.Add(-time.Minute)))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"score":100}`, w.Body.String())
+}
+
+func TestSignatureMiddleware_RequireSignature_WhenNonceReplayed_ShouldReturn401(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	router := newSignatureTestRouter(now)
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, newSignedRequest(`{"score":100}`, "nonce-1", now))
+	require.Equal(t, http.StatusOK, first.Code)
+
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, newSignedRequest(`{"score":100}`, "nonce-1", now))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Contains(t, w.Body.String(), "already been used")
+}
+
+func TestSignatureMiddleware_RequireSignature_WhenTimestampStale_ShouldReturn401(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	router := newSignatureTestRouter(now)
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, newSignedRequest(`{"score":100}`, "nonce-1", now.Add(-6*time.Minute)))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Contains(t, w.Body.String(), "expired")
+}
+
+func TestSignatureMiddleware_RequireSignature_WhenBodyTampered_ShouldReturn401AndKeepNonceUnused(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	router := newSignatureTestRouter(now)
+	tampered := newSignedRequest(`{"score":100}`, "nonce-1", now)
+	tampered.Body = io.NopCloser(strings.NewReader(`{"score":999999}`))
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, tampered)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Contains(t, w.Body.String(), "Invalid request signature")
+
+	genuine := httptest.NewRecorder()
+	router.ServeHTTP(genuine, newSignedRequest(`{"score":100}`, "nonce-1", now))
+	require.Equal(t, http.StatusOK, genuine.Code)
+}
+
+func TestSignatureMiddleware_RequireSignature_WhenHeadersMissing_ShouldReturn401(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	router := newSignatureTestRouter(time.Now())
+	w := httptest.NewRecorder()
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/score", strings.NewReader(`{"score":100}`)))
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -1,10 +1,17 @@
 // Package request provides common request structures for API endpoints.
 package request
 
-// Pagination represents pagination parameters for list endpoints
+import (
+	"fmt"
+
+	"real-time-leaderboard/internal/shared/validator"
+)
+
+// Pagination represents pagination parameters for list endpoints.
+// An omitted or zero limit becomes DefaultLimit in Validate; other out-of-range values fail it.
 type Pagination struct {
 	Offset int64 `json:"offset" form:"offset" validate:"min=0"`
-	Limit  int64 `json:"limit" form:"limit" validate:"min=1,max=100"`
+	Limit  int64 `json:"limit" form:"limit" validate:"min=1"`
 }
 
 const (
@@ -18,6 +25,25 @@ const (
 	MinLimit int64 = 1
 )
 
+// Validate sets a zero limit to DefaultLimit, then checks that offset >= 0 and MinLimit <= limit <= maxLimit.
+// A non-positive maxLimit falls back to MaxLimit.
+func (p *Pagination) Validate(maxLimit int64) error {
+	if p.Limit == 0 {
+		p.Limit = DefaultLimit
+	}
+	if err := validator.Validate(p); err != nil {
+		return err
+	}
+
+	if maxLimit <= 0 {
+		maxLimit = MaxLimit
+	}
+	if p.Limit > maxLimit {
+		return &validator.ValidationError{Message: fmt.Sprintf("limit must be at most %d", maxLimit)}
+	}
+	return nil
+}
+
 // Normalize applies default values and enforces bounds for pagination parameters
 func (p *Pagination) Normalize() *Pagination {
 	if p.Limit <= 0 {
@@ -0,0 +1,86 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/shared/validator"
+)
+
+func TestPagination_Validate_WhenLimitIsZero_ShouldApplyDefaultLimit(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	p := Pagination{Offset: 0, Limit: 0}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := p.Validate(MaxLimit)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, DefaultLimit, p.Limit)
+}
+
+func TestPagination_Validate_WhenLimitNegative_ShouldReturnValidationError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	p := Pagination{Offset: 0, Limit: -1}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := p.Validate(MaxLimit)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.True(t, validator.IsValidationError(err))
+}
+
+func TestPagination_Validate_WhenLimitAtBounds_ShouldSucceed(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	lower := Pagination{Offset: 0, Limit: MinLimit}
+	upper := Pagination{Offset: 0, Limit: MaxLimit}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	lowerErr := lower.Validate(MaxLimit)
+	upperErr := upper.Validate(MaxLimit)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, lowerErr)
+	require.NoError(t, upperErr)
+}
+
+func TestPagination_Validate_WhenLimitAboveMax_ShouldReturnValidationError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	p := Pagination{Offset: 0, Limit: 51}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := p.Validate(50)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.True(t, validator.IsValidationError(err))
+	require.Contains(t, err.Error(), "at most 50")
+}
+
+func TestPagination_Validate_WhenMaxLimitNotSet_ShouldFallBackToMaxLimit(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	atMax := Pagination{Offset: 0, Limit: MaxLimit}
+	aboveMax := Pagination{Offset: 0, Limit: MaxLimit + 1}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	atMaxErr := atMax.Validate(0)
+	aboveMaxErr := aboveMax.Validate(0)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, atMaxErr)
+	require.Error(t, aboveMaxErr)
+}
+
+func TestPagination_Validate_WhenOffsetNegative_ShouldReturnValidationError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	p := Pagination{Offset: -1, Limit: DefaultLimit}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := p.Validate(MaxLimit)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Error(t, err)
+	require.True(t, validator.IsValidationError(err))
+}
@@ -4,6 +4,7 @@ package response
 import (
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // ErrorCode represents application error codes
@@ -26,6 +27,10 @@ const (
 	CodeBadRequest ErrorCode = "BAD_REQUEST"
 	// CodeTooManyRequests represents a too many requests error.
 	CodeTooManyRequests ErrorCode = "TOO_MANY_REQUESTS"
+	// CodeTimeout represents an upstream operation that did not finish in time.
+	CodeTimeout ErrorCode = "TIMEOUT"
+	// CodeServiceUnavailable represents a dependency the request needs being unavailable.
+	CodeServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
 )
 
 // APIError represents an API error (user-facing only)
@@ -33,6 +38,8 @@ type APIError struct {
 	Code       ErrorCode `json:"code"`
 	Message    string    `json:"message"`
 	HTTPStatus int       `json:"-"`
+	// RetryAfter, when positive, is sent as the Retry-After header (in whole seconds)
+	RetryAfter time.Duration `json:"-"`
 }
 
 // Error implements the error interface
@@ -109,16 +116,45 @@ func NewBadRequestError(message string) *APIError {
 	}
 }
 
-// NewTooManyRequestsError creates a new too many requests error
-func NewTooManyRequestsError(message string) *APIError {
+// NewTooManyRequestsError creates a new too many requests error.
+// An optional retryAfter tells the client when to retry via the Retry-After header.
+func NewTooManyRequestsError(message string, retryAfter ...time.Duration) *APIError {
 	if message == "" {
 		message = "Too many requests"
 	}
-	return &APIError{
+	apiErr := &APIError{
 		Code:       CodeTooManyRequests,
 		Message:    message,
 		HTTPStatus: http.StatusTooManyRequests,
 	}
+	if len(retryAfter) > 0 {
+		apiErr.RetryAfter = retryAfter[0]
+	}
+	return apiErr
+}
+
+// NewTimeoutError creates a new gateway timeout error
+func NewTimeoutError(message string) *APIError {
+	if message == "" {
+		message = "Request timed out"
+	}
+	return &APIError{
+		Code:       CodeTimeout,
+		Message:    message,
+		HTTPStatus: http.StatusGatewayTimeout,
+	}
+}
+
+// NewServiceUnavailableError creates a new service unavailable error
+func NewServiceUnavailableError(message string) *APIError {
+	if message == "" {
+		message = "Service unavailable"
+	}
+	return &APIError{
+		Code:       CodeServiceUnavailable,
+		Message:    message,
+		HTTPStatus: http.StatusServiceUnavailable,
+	}
 }
 
 // IsAPIError checks if an error is an APIError
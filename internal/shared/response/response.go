@@ -2,7 +2,9 @@
 package response
 
 import (
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,8 +16,13 @@ type Response struct {
 	Error   *ErrorInfo  `json:"error,omitempty"`
 	Message string      `json:"message,omitempty"`
 	Meta    interface{} `json:"meta,omitempty"`
+	// APIVersion is only set when the API version middleware is installed
+	APIVersion string `json:"api_version,omitempty"`
 }
 
+// APIVersionKey is the gin context key holding the API version reported in the envelope
+const APIVersionKey = "api_version"
+
 // ErrorInfo represents error information in response
 type ErrorInfo struct {
 	Code    string `json:"code"`
@@ -56,40 +63,49 @@ func NewPagination(offset, limit int64, total int64) Pagination {
 // Success sends a successful response
 func Success(c *gin.Context, data interface{}, message string) {
 	c.JSON(http.StatusOK, Response{
-		Success: true,
-		Data:    data,
-		Message: message,
+		Success:    true,
+		Data:       data,
+		Message:    message,
+		APIVersion: c.GetString(APIVersionKey),
 	})
 }
 
 // SuccessWithStatus sends a successful response with custom status code
 func SuccessWithStatus(c *gin.Context, status int, data interface{}, message string) {
 	c.JSON(status, Response{
-		Success: true,
-		Data:    data,
-		Message: message,
+		Success:    true,
+		Data:       data,
+		Message:    message,
+		APIVersion: c.GetString(APIVersionKey),
 	})
 }
 
 // Error sends an error response
 // The caller is responsible for logging the error before calling this function
 func Error(c *gin.Context, err *APIError) {
+	if err.RetryAfter > 0 {
+		// Round up so clients never retry before the limit actually resets
+		seconds := int64(math.Ceil(err.RetryAfter.Seconds()))
+		c.Header("Retry-After", strconv.FormatInt(seconds, 10))
+	}
 	c.JSON(err.HTTPStatus, Response{
 		Success: false,
 		Error: &ErrorInfo{
 			Code:    string(err.Code),
 			Message: err.Message,
 		},
+		APIVersion: c.GetString(APIVersionKey),
 	})
 }
 
 // SuccessWithMeta sends a successful response with custom metadata
 func SuccessWithMeta(c *gin.Context, data interface{}, message string, meta interface{}) {
 	c.JSON(http.StatusOK, Response{
-		Success: true,
-		Data:    data,
-		Message: message,
-		Meta:    meta,
+		Success:    true,
+		Data:       data,
+		Message:    message,
+		Meta:       meta,
+		APIVersion: c.GetString(APIVersionKey),
 	})
 }
 
@@ -0,0 +1,58 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestError_WhenTooManyRequestsWithRetryAfter_ShouldSetRetryAfterHeader(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	apiErr := NewTooManyRequestsError("", 30*time.Second)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	Error(c, apiErr)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "30", w.Header().Get("Retry-After"))
+}
+
+func TestError_WhenRetryAfterHasFraction_ShouldRoundUpToWholeSeconds(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	apiErr := NewTooManyRequestsError("Too many login attempts", 1500*time.Millisecond)
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	Error(c, apiErr)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, "2", w.Header().Get("Retry-After"))
+}
+
+func TestError_WhenNoRetryAfter_ShouldOmitHeader(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	apiErr := NewTooManyRequestsError("")
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	Error(c, apiErr)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Empty(t, w.Header().Get("Retry-After"))
+}
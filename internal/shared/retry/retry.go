@@ -0,0 +1,51 @@
+// Package retry provides bounded retry with exponential backoff for startup dependencies.
+package retry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// Policy bounds the attempts made by Connect
+type Policy struct {
+	// MaxAttempts is the total number of attempts; values below 1 mean a single attempt
+	MaxAttempts int
+	// BaseDelay is the wait after the first failure; it doubles after each further failure
+	BaseDelay time.Duration
+}
+
+// Connect calls connect until it succeeds or policy.MaxAttempts is reached.
+// The delay between attempts starts at policy.BaseDelay and doubles after each failure.
+func Connect[T any](ctx context.Context, policy Policy, name string, l *logger.Logger, connect func() (T, error)) (T, error) {
+	var zero T
+
+	attempts := max(policy.MaxAttempts, 1)
+	delay := policy.BaseDelay
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		conn, err := connect()
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+
+		if attempt == attempts {
+			break
+		}
+
+		l.Warnf(ctx, "Failed to connect to %s (attempt %d/%d), retrying in %s: %v", name, attempt, attempts, delay, err)
+
+		select {
+		case <-ctx.Done():
+			return zero, fmt.Errorf("%s connection canceled: %w", name, ctx.Err())
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+
+	return zero, fmt.Errorf("failed to connect to %s after %d attempts: %w", name, attempts, lastErr)
+}
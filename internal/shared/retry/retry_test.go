@@ -0,0 +1,76 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+func TestConnect_WhenConnectorFailsTwiceThenSucceeds_ShouldReturnConnection(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	policy := Policy{MaxAttempts: 5, BaseDelay: time.Millisecond}
+
+	calls := 0
+	connector := func() (string, error) {
+		calls++
+		if calls <= 2 {
+			return "", errors.New("connection refused")
+		}
+		return "conn", nil
+	}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	conn, err := Connect(ctx, policy, "test", logger.New("info", false), connector)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.NoError(t, err)
+	require.Equal(t, "conn", conn)
+	require.Equal(t, 3, calls)
+}
+
+func TestConnect_WhenAttemptsExhausted_ShouldReturnLastError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx := context.Background()
+	policy := Policy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	connErr := errors.New("connection refused")
+	calls := 0
+	connector := func() (string, error) {
+		calls++
+		return "", connErr
+	}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	conn, err := Connect(ctx, policy, "test", logger.New("info", false), connector)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, connErr)
+	require.Empty(t, conn)
+	require.Equal(t, 3, calls)
+}
+
+func TestConnect_WhenContextCanceled_ShouldStopRetrying(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := Policy{MaxAttempts: 5, BaseDelay: time.Hour}
+
+	calls := 0
+	connector := func() (string, error) {
+		calls++
+		cancel()
+		return "", errors.New("connection refused")
+	}
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	_, err := Connect(ctx, policy, "test", logger.New("info", false), connector)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,54 @@
+// Package supervisor runs the server's background workers and stops them together on shutdown.
+package supervisor
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+// Worker is a background job that runs until ctx is cancelled.
+// A returned error stops the other workers too.
+type Worker func(ctx context.Context) error
+
+// namedWorker pairs a worker with the name used in logs and errors
+type namedWorker struct {
+	name string
+	run  Worker
+}
+
+// Supervisor starts registered workers together and waits for all of them to exit
+type Supervisor struct {
+	workers []namedWorker
+	logger  *logger.Logger
+}
+
+// New creates a supervisor with no workers
+func New(l *logger.Logger) *Supervisor {
+	return &Supervisor{logger: l}
+}
+
+// Add registers a worker to start on Run; it must be called before Run
+func (s *Supervisor) Add(name string, w Worker) {
+	s.workers = append(s.workers, namedWorker{name: name, run: w})
+}
+
+// Run starts every worker and blocks until all have exited. Cancelling ctx, or any worker failing,
+// cancels the context of the rest. It returns the first worker error, or nil after a clean shutdown.
+func (s *Supervisor) Run(ctx context.Context) error {
+	g, gctx := errgroup.WithContext(ctx)
+	for _, w := range s.workers {
+		g.Go(func() error {
+			s.logger.Infof(gctx, "Background worker started: %s", w.name)
+			if err := w.run(gctx); err != nil {
+				return fmt.Errorf("background worker %s: %w", w.name, err)
+			}
+			s.logger.Infof(gctx, "Background worker stopped: %s", w.name)
+			return nil
+		})
+	}
+	return g.Wait()
+}
@@ -0,0 +1,68 @@
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"real-time-leaderboard/internal/shared/logger"
+)
+
+func TestSupervisor_Run_WhenContextCancelled_ShouldStopWorkersAndReturn(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	ctx, cancel := context.WithCancel(context.Background())
+	s := New(logger.New("info", false))
+
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	s.Add("fake", func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		close(stopped)
+		return nil
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+	<-started
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	cancel()
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("supervisor did not return after cancellation")
+	}
+	select {
+	case <-stopped:
+	default:
+		t.Fatal("Run returned before the worker exited")
+	}
+}
+
+func TestSupervisor_Run_WhenWorkerFails_ShouldCancelOthersAndReturnError(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	s := New(logger.New("info", false))
+	failure := errors.New("boom")
+
+	s.Add("steady", func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+	s.Add("failing", func(context.Context) error {
+		return failure
+	})
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	err := s.Run(context.Background())
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.ErrorIs(t, err, failure)
+	require.ErrorContains(t, err, "failing")
+}
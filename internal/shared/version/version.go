@@ -0,0 +1,58 @@
+// Package version exposes build metadata injected at link time.
+//
+// Build with:
+//
+//	go build -ldflags "-X real-time-leaderboard/internal/shared/version.Version=v1.2.3 \
+//	  -X real-time-leaderboard/internal/shared/version.Commit=$(git rev-parse --short HEAD) \
+//	  -X real-time-leaderboard/internal/shared/version.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+package version
+
+import (
+	"runtime"
+
+	"github.com/gin-gonic/gin"
+
+	"real-time-leaderboard/internal/shared/response"
+)
+
+// Set via -ldflags -X; empty when not injected
+var (
+	Version   string
+	Commit    string
+	BuildTime string
+)
+
+const (
+	defaultVersion = "dev"
+	unknown        = "unknown"
+)
+
+// Info describes the running build
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build info, substituting defaults for values not injected at link time
+func Get() Info {
+	return Info{
+		Version:   orDefault(Version, defaultVersion),
+		Commit:    orDefault(Commit, unknown),
+		BuildTime: orDefault(BuildTime, unknown),
+		GoVersion: runtime.Version(),
+	}
+}
+
+// Handler serves the build info as JSON
+func Handler(c *gin.Context) {
+	response.Success(c, Get(), "Build info retrieved successfully")
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
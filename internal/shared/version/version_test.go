@@ -0,0 +1,70 @@
+package version
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func serveVersion(t *testing.T) Info {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/version", Handler)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/version", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var body struct {
+		Success bool `json:"success"`
+		Data    Info `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.True(t, body.Success)
+	return body.Data
+}
+
+func setBuildInfo(t *testing.T, version, commit, buildTime string) {
+	t.Helper()
+
+	prevVersion, prevCommit, prevBuildTime := Version, Commit, BuildTime
+	t.Cleanup(func() {
+		Version, Commit, BuildTime = prevVersion, prevCommit, prevBuildTime
+	})
+	Version, Commit, BuildTime = version, commit, buildTime
+}
+
+func TestHandler_WhenValuesInjected_ShouldReturnThem(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	setBuildInfo(t, "v1.2.3", "abc1234", "2024-01-01T00:00:00Z")
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	info := serveVersion(t)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, "v1.2.3", info.Version)
+	require.Equal(t, "abc1234", info.Commit)
+	require.Equal(t, "2024-01-01T00:00:00Z", info.BuildTime)
+	require.Equal(t, runtime.Version(), info.GoVersion)
+}
+
+func TestHandler_WhenValuesNotInjected_ShouldReturnDefaults(t *testing.T) {
+	// ── Arrange ────────────────────────────────────────────────────────
+	setBuildInfo(t, "", "", "")
+
+	// ── Act ─────────────────────────────────────────────────────────────
+	info := serveVersion(t)
+
+	// ── Assert ──────────────────────────────────────────────────────────
+	require.Equal(t, "dev", info.Version)
+	require.Equal(t, "unknown", info.Commit)
+	require.Equal(t, "unknown", info.BuildTime)
+	require.Equal(t, runtime.Version(), info.GoVersion)
+}
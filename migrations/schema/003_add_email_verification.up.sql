@@ -0,0 +1,5 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS email_verification_token_hash VARCHAR(64);
+
+CREATE UNIQUE INDEX idx_users_email_verification_token_hash ON users(email_verification_token_hash);
@@ -0,0 +1,13 @@
+-- Append-only record of every score submission attempt, kept for dispute resolution.
+-- No foreign key on user_id so the history survives user deletion.
+CREATE TABLE IF NOT EXISTS score_audit (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    score BIGINT NOT NULL,
+    accepted BOOLEAN NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_score_audit_user_id_created_at ON score_audit(user_id, created_at DESC);
+CREATE INDEX idx_score_audit_created_at ON score_audit(created_at DESC);
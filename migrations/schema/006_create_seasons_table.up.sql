@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS seasons (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ended_at TIMESTAMP
+);
+
+-- At most one season is open at a time
+CREATE UNIQUE INDEX idx_seasons_active ON seasons((ended_at IS NULL)) WHERE ended_at IS NULL;
+CREATE INDEX idx_seasons_started_at ON seasons(started_at DESC);
+
+-- Final standings of ended seasons, copied from the leaderboard when the season is archived.
+-- No foreign key on user_id so the history survives user deletion.
+CREATE TABLE IF NOT EXISTS season_standings (
+    season_id UUID NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    score BIGINT NOT NULL,
+    rank BIGINT NOT NULL,
+    PRIMARY KEY (season_id, user_id)
+);
+
+CREATE INDEX idx_season_standings_season_rank ON season_standings(season_id, rank);
@@ -0,0 +1,2 @@
+ALTER TABLE score_audit
+    DROP COLUMN IF EXISTS delta;
@@ -0,0 +1,3 @@
+-- Increments are audited with the delta they added; submissions and admin sets leave it NULL
+ALTER TABLE score_audit
+    ADD COLUMN IF NOT EXISTS delta BIGINT;
@@ -68,6 +68,12 @@ class LeaderboardManager {
             }
         };
 
+        // Server closes streams that reach their max duration; the browser reconnects on its own,
+        // so only reload the snapshot to cover updates missed in between
+        this.eventSource.addEventListener('reconnect', () => {
+            this.loadInitialLeaderboard(this.limit);
+        });
+
         this.eventSource.onerror = (error) => {
             // Only update status if connection is actually closed
             if (this.eventSource && this.eventSource.readyState === EventSource.CLOSED) {
@@ -120,6 +126,10 @@ class LeaderboardManager {
             }
         };
 
+        this.eventSource.addEventListener('reconnect', () => {
+            this.loadInitialLeaderboard(this.limit);
+        });
+
         this.eventSource.onerror = (error) => {
             if (this.eventSource && this.eventSource.readyState === EventSource.CLOSED) {
                 console.error('SSE connection error:', error);